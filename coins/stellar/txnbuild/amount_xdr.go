@@ -0,0 +1,31 @@
+package txnbuild
+
+import (
+	"github.com/okx/go-wallet-sdk/coins/stellar/amount"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// amountToXDRInt64 parses a decimal stellar amount string into the raw XDR Int64 representation
+// (7 decimal places fixed point).
+func amountToXDRInt64(v string) (xdr.Int64, error) {
+	parsed, err := amount.ParseInt64(v)
+	if err != nil {
+		return 0, err
+	}
+	return xdr.Int64(parsed), nil
+}
+
+// amountFromXDRInt64 renders a raw XDR Int64 amount as a decimal stellar amount string.
+func amountFromXDRInt64(v xdr.Int64) string {
+	return amount.StringFromInt64(int64(v))
+}
+
+// priceToXDR parses a decimal price string into an xdr.Price fraction.
+func priceToXDR(v string) (xdr.Price, error) {
+	return xdr.NewPrice(v)
+}
+
+// priceFromXDR renders an xdr.Price fraction as a decimal price string.
+func priceFromXDR(p xdr.Price) string {
+	return p.String()
+}
@@ -0,0 +1,15 @@
+package txnbuild
+
+import "fmt"
+
+// CanonicalString returns the SEP-11 canonical string representation of the asset, e.g. "native"
+// or "CODE:ISSUER". See
+// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0011.md#asset
+func (na NativeAsset) CanonicalString() string {
+	return "native"
+}
+
+// CanonicalString returns the SEP-11 canonical string representation of the asset, "CODE:ISSUER".
+func (ca CreditAsset) CanonicalString() string {
+	return fmt.Sprintf("%s:%s", ca.Code, ca.Issuer)
+}
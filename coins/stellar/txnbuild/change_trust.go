@@ -0,0 +1,75 @@
+package txnbuild
+
+import (
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// ChangeTrust represents the Stellar change_trust operation. See
+// https://developers.stellar.org/docs/start/list-of-operations/#change-trust
+type ChangeTrust struct {
+	SourceAccount string
+	Line          ChangeTrustAsset
+	Limit         string
+}
+
+// BuildXDR for ChangeTrust returns a fully configured XDR Operation.
+func (ct *ChangeTrust) BuildXDR() (xdr.Operation, error) {
+	xdrLine, err := ct.Line.ToXDR()
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to convert Line to xdr")
+	}
+
+	xdrLimit, err := amountToXDRInt64(ct.Limit)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse Limit")
+	}
+
+	xdrOp := xdr.ChangeTrustOp{
+		Line:  xdrLine,
+		Limit: xdrLimit,
+	}
+
+	body, err := xdr.NewOperationBody(xdr.OperationTypeChangeTrust, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, ct.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for ChangeTrust populates the receiver with information from the supplied XDR Operation.
+func (ct *ChangeTrust) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetChangeTrustOp()
+	if !ok {
+		return errors.New("error parsing change_trust operation from xdr")
+	}
+
+	line, err := changeTrustAssetFromXDR(result.Line)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse Line")
+	}
+
+	ct.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+	ct.Line = line
+	ct.Limit = amountFromXDRInt64(result.Limit)
+	return nil
+}
+
+// Validate for ChangeTrust validates the required struct fields.
+func (ct *ChangeTrust) Validate() error {
+	if err := validateChangeTrustAsset(ct.Line); err != nil {
+		return NewValidationError("Line", err.Error())
+	}
+	if err := validateAmount(ct.Limit); err != nil {
+		return NewValidationError("Limit", err.Error())
+	}
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation.
+func (ct *ChangeTrust) GetSourceAccount() string {
+	return ct.SourceAccount
+}
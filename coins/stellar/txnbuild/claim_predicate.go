@@ -0,0 +1,255 @@
+package txnbuild
+
+import (
+	"time"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// maxClaimPredicateDepth is the maximum nesting depth allowed for a ClaimPredicate tree, matching
+// the limit enforced by stellar-core.
+const maxClaimPredicateDepth = 4
+
+// ClaimPredicateType identifies which variant of ClaimPredicate is populated.
+type ClaimPredicateType int
+
+// ClaimPredicateType values.
+const (
+	ClaimPredicateUnconditionalType ClaimPredicateType = iota
+	ClaimPredicateAndType
+	ClaimPredicateOrType
+	ClaimPredicateNotType
+	ClaimPredicateBeforeAbsoluteTimeType
+	ClaimPredicateBeforeRelativeTimeType
+)
+
+// ClaimPredicate describes the condition under which a Claimant may claim a claimable balance.
+// Construct one with the ClaimPredicate* builder functions rather than populating the struct
+// directly.
+type ClaimPredicate struct {
+	Type          ClaimPredicateType
+	AndPredicates []ClaimPredicate
+	OrPredicates  []ClaimPredicate
+	NotPredicate  *ClaimPredicate
+	AbsBefore     time.Time
+	RelBefore     time.Duration
+}
+
+// ClaimPredicateUnconditional returns a predicate that is always satisfied.
+func ClaimPredicateUnconditional() ClaimPredicate {
+	return ClaimPredicate{Type: ClaimPredicateUnconditionalType}
+}
+
+// ClaimPredicateAnd returns a predicate satisfied only when both l and r are satisfied.
+func ClaimPredicateAnd(l, r ClaimPredicate) ClaimPredicate {
+	return ClaimPredicate{Type: ClaimPredicateAndType, AndPredicates: []ClaimPredicate{l, r}}
+}
+
+// ClaimPredicateOr returns a predicate satisfied when either l or r is satisfied.
+func ClaimPredicateOr(l, r ClaimPredicate) ClaimPredicate {
+	return ClaimPredicate{Type: ClaimPredicateOrType, OrPredicates: []ClaimPredicate{l, r}}
+}
+
+// ClaimPredicateNot returns a predicate satisfied when p is not satisfied.
+func ClaimPredicateNot(p ClaimPredicate) ClaimPredicate {
+	return ClaimPredicate{Type: ClaimPredicateNotType, NotPredicate: &p}
+}
+
+// ClaimPredicateBeforeAbsoluteTime returns a predicate satisfied while the ledger close time is
+// strictly before t.
+func ClaimPredicateBeforeAbsoluteTime(t time.Time) ClaimPredicate {
+	return ClaimPredicate{Type: ClaimPredicateBeforeAbsoluteTimeType, AbsBefore: t}
+}
+
+// ClaimPredicateBeforeRelativeTime returns a predicate satisfied while the ledger close time is
+// strictly before the claimable balance's creation time plus d.
+func ClaimPredicateBeforeRelativeTime(d time.Duration) ClaimPredicate {
+	return ClaimPredicate{Type: ClaimPredicateBeforeRelativeTimeType, RelBefore: d}
+}
+
+// Evaluate recursively evaluates the predicate tree using the same semantics as stellar-core:
+// relative-time predicates are compared against closeTime - createTime, absolute-time predicates
+// against closeTime, and And/Or short-circuit.
+func (p ClaimPredicate) Evaluate(closeTime, createTime time.Time) bool {
+	switch p.Type {
+	case ClaimPredicateUnconditionalType:
+		return true
+	case ClaimPredicateAndType:
+		for _, sub := range p.AndPredicates {
+			if !sub.Evaluate(closeTime, createTime) {
+				return false
+			}
+		}
+		return true
+	case ClaimPredicateOrType:
+		for _, sub := range p.OrPredicates {
+			if sub.Evaluate(closeTime, createTime) {
+				return true
+			}
+		}
+		return false
+	case ClaimPredicateNotType:
+		if p.NotPredicate == nil {
+			return false
+		}
+		return !p.NotPredicate.Evaluate(closeTime, createTime)
+	case ClaimPredicateBeforeAbsoluteTimeType:
+		return closeTime.Before(p.AbsBefore)
+	case ClaimPredicateBeforeRelativeTimeType:
+		return closeTime.Sub(createTime) < p.RelBefore
+	default:
+		return false
+	}
+}
+
+// Validate checks that the predicate tree does not exceed the maximum nesting depth allowed by
+// stellar-core (4 levels). It returns a ValidationError if the tree is too deep.
+func (p ClaimPredicate) Validate() error {
+	return p.validateDepth(1)
+}
+
+func (p ClaimPredicate) validateDepth(depth int) error {
+	if depth > maxClaimPredicateDepth {
+		return NewValidationError("Predicate", "exceeds the maximum nesting depth of 4 levels")
+	}
+
+	switch p.Type {
+	case ClaimPredicateAndType:
+		for _, sub := range p.AndPredicates {
+			if err := sub.validateDepth(depth + 1); err != nil {
+				return err
+			}
+		}
+	case ClaimPredicateOrType:
+		for _, sub := range p.OrPredicates {
+			if err := sub.validateDepth(depth + 1); err != nil {
+				return err
+			}
+		}
+	case ClaimPredicateNotType:
+		if p.NotPredicate != nil {
+			return p.NotPredicate.validateDepth(depth + 1)
+		}
+	}
+
+	return nil
+}
+
+// toXDR converts a ClaimPredicate to its xdr.ClaimPredicate representation.
+func (p ClaimPredicate) toXDR() (xdr.ClaimPredicate, error) {
+	switch p.Type {
+	case ClaimPredicateUnconditionalType:
+		return xdr.NewClaimPredicate(xdr.ClaimPredicateTypeClaimPredicateUnconditional, nil)
+
+	case ClaimPredicateAndType:
+		if len(p.AndPredicates) != 2 {
+			return xdr.ClaimPredicate{}, errors.New("AndPredicates must contain exactly 2 predicates")
+		}
+		xdrPredicates := make([]xdr.ClaimPredicate, 2)
+		for i, sub := range p.AndPredicates {
+			xdrSub, err := sub.toXDR()
+			if err != nil {
+				return xdr.ClaimPredicate{}, err
+			}
+			xdrPredicates[i] = xdrSub
+		}
+		return xdr.NewClaimPredicate(xdr.ClaimPredicateTypeClaimPredicateAnd, &xdrPredicates)
+
+	case ClaimPredicateOrType:
+		if len(p.OrPredicates) != 2 {
+			return xdr.ClaimPredicate{}, errors.New("OrPredicates must contain exactly 2 predicates")
+		}
+		xdrPredicates := make([]xdr.ClaimPredicate, 2)
+		for i, sub := range p.OrPredicates {
+			xdrSub, err := sub.toXDR()
+			if err != nil {
+				return xdr.ClaimPredicate{}, err
+			}
+			xdrPredicates[i] = xdrSub
+		}
+		return xdr.NewClaimPredicate(xdr.ClaimPredicateTypeClaimPredicateOr, &xdrPredicates)
+
+	case ClaimPredicateNotType:
+		if p.NotPredicate == nil {
+			return xdr.ClaimPredicate{}, errors.New("NotPredicate is undefined")
+		}
+		xdrSub, err := p.NotPredicate.toXDR()
+		if err != nil {
+			return xdr.ClaimPredicate{}, err
+		}
+		return xdr.NewClaimPredicate(xdr.ClaimPredicateTypeClaimPredicateNot, &xdrSub)
+
+	case ClaimPredicateBeforeAbsoluteTimeType:
+		t := xdr.Int64(p.AbsBefore.Unix())
+		return xdr.NewClaimPredicate(xdr.ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime, &t)
+
+	case ClaimPredicateBeforeRelativeTimeType:
+		d := xdr.Int64(int64(p.RelBefore / time.Second))
+		return xdr.NewClaimPredicate(xdr.ClaimPredicateTypeClaimPredicateBeforeRelativeTime, &d)
+
+	default:
+		return xdr.ClaimPredicate{}, errors.New("unknown ClaimPredicateType")
+	}
+}
+
+// claimPredicateFromXDR converts an xdr.ClaimPredicate into a ClaimPredicate.
+func claimPredicateFromXDR(xdrPredicate xdr.ClaimPredicate) (ClaimPredicate, error) {
+	switch xdrPredicate.Type {
+	case xdr.ClaimPredicateTypeClaimPredicateUnconditional:
+		return ClaimPredicateUnconditional(), nil
+
+	case xdr.ClaimPredicateTypeClaimPredicateAnd:
+		if xdrPredicate.AndPredicates == nil || len(*xdrPredicate.AndPredicates) != 2 {
+			return ClaimPredicate{}, errors.New("malformed AndPredicates")
+		}
+		l, err := claimPredicateFromXDR((*xdrPredicate.AndPredicates)[0])
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		r, err := claimPredicateFromXDR((*xdrPredicate.AndPredicates)[1])
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		return ClaimPredicateAnd(l, r), nil
+
+	case xdr.ClaimPredicateTypeClaimPredicateOr:
+		if xdrPredicate.OrPredicates == nil || len(*xdrPredicate.OrPredicates) != 2 {
+			return ClaimPredicate{}, errors.New("malformed OrPredicates")
+		}
+		l, err := claimPredicateFromXDR((*xdrPredicate.OrPredicates)[0])
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		r, err := claimPredicateFromXDR((*xdrPredicate.OrPredicates)[1])
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		return ClaimPredicateOr(l, r), nil
+
+	case xdr.ClaimPredicateTypeClaimPredicateNot:
+		if xdrPredicate.NotPredicate == nil {
+			return ClaimPredicate{}, errors.New("malformed NotPredicate")
+		}
+		sub, err := claimPredicateFromXDR(*xdrPredicate.NotPredicate)
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		return ClaimPredicateNot(sub), nil
+
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeAbsoluteTime:
+		if xdrPredicate.AbsBefore == nil {
+			return ClaimPredicate{}, errors.New("malformed AbsBefore")
+		}
+		return ClaimPredicateBeforeAbsoluteTime(time.Unix(int64(*xdrPredicate.AbsBefore), 0).UTC()), nil
+
+	case xdr.ClaimPredicateTypeClaimPredicateBeforeRelativeTime:
+		if xdrPredicate.RelBefore == nil {
+			return ClaimPredicate{}, errors.New("malformed RelBefore")
+		}
+		return ClaimPredicateBeforeRelativeTime(time.Duration(*xdrPredicate.RelBefore) * time.Second), nil
+
+	default:
+		return ClaimPredicate{}, errors.New("unknown xdr ClaimPredicateType")
+	}
+}
@@ -0,0 +1,180 @@
+package txnbuild
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimPredicateUnconditionalEvaluate(t *testing.T) {
+	p := ClaimPredicateUnconditional()
+	assert.True(t, p.Evaluate(time.Now(), time.Now()))
+}
+
+func TestClaimPredicateBeforeAbsoluteTimeEvaluate(t *testing.T) {
+	cutoff := time.Unix(2000000000, 0)
+	p := ClaimPredicateBeforeAbsoluteTime(cutoff)
+
+	assert.True(t, p.Evaluate(cutoff.Add(-time.Second), time.Time{}))
+	assert.False(t, p.Evaluate(cutoff.Add(time.Second), time.Time{}))
+}
+
+func TestClaimPredicateBeforeRelativeTimeEvaluate(t *testing.T) {
+	p := ClaimPredicateBeforeRelativeTime(10 * time.Second)
+	createTime := time.Unix(1000000000, 0)
+
+	assert.True(t, p.Evaluate(createTime.Add(5*time.Second), createTime))
+	assert.False(t, p.Evaluate(createTime.Add(15*time.Second), createTime))
+}
+
+func TestClaimPredicateAndOrNotEvaluate(t *testing.T) {
+	createTime := time.Unix(1000000000, 0)
+	closeTime := createTime.Add(5 * time.Second)
+
+	and := ClaimPredicateAnd(
+		ClaimPredicateBeforeRelativeTime(10*time.Second),
+		ClaimPredicateBeforeRelativeTime(3*time.Second),
+	)
+	assert.False(t, and.Evaluate(closeTime, createTime))
+
+	or := ClaimPredicateOr(
+		ClaimPredicateBeforeRelativeTime(10*time.Second),
+		ClaimPredicateBeforeRelativeTime(3*time.Second),
+	)
+	assert.True(t, or.Evaluate(closeTime, createTime))
+
+	not := ClaimPredicateNot(ClaimPredicateBeforeRelativeTime(3 * time.Second))
+	assert.True(t, not.Evaluate(closeTime, createTime))
+}
+
+func TestClaimPredicateValidateDepth(t *testing.T) {
+	deep := ClaimPredicateUnconditional()
+	for i := 0; i < 4; i++ {
+		deep = ClaimPredicateNot(deep)
+	}
+	err := deep.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "nesting depth")
+	}
+
+	shallow := ClaimPredicateNot(ClaimPredicateNot(ClaimPredicateUnconditional()))
+	assert.NoError(t, shallow.Validate())
+}
+
+// assertPredicateEqual compares two ClaimPredicate trees by value, normalizing AbsBefore to its
+// Unix seconds since toXDR/claimPredicateFromXDR only round-trip second-level precision.
+func assertPredicateEqual(t *testing.T, expected, actual ClaimPredicate) {
+	t.Helper()
+	assert.Equal(t, expected.Type, actual.Type)
+	assert.Equal(t, expected.AbsBefore.Unix(), actual.AbsBefore.Unix())
+	assert.Equal(t, expected.RelBefore, actual.RelBefore)
+
+	switch expected.Type {
+	case ClaimPredicateAndType:
+		if assert.Len(t, actual.AndPredicates, len(expected.AndPredicates)) {
+			for i := range expected.AndPredicates {
+				assertPredicateEqual(t, expected.AndPredicates[i], actual.AndPredicates[i])
+			}
+		}
+	case ClaimPredicateOrType:
+		if assert.Len(t, actual.OrPredicates, len(expected.OrPredicates)) {
+			for i := range expected.OrPredicates {
+				assertPredicateEqual(t, expected.OrPredicates[i], actual.OrPredicates[i])
+			}
+		}
+	case ClaimPredicateNotType:
+		if assert.NotNil(t, actual.NotPredicate) && expected.NotPredicate != nil {
+			assertPredicateEqual(t, *expected.NotPredicate, *actual.NotPredicate)
+		}
+	}
+}
+
+func TestClaimPredicateXDRRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		p    ClaimPredicate
+	}{
+		{"Unconditional", ClaimPredicateUnconditional()},
+		{"BeforeAbsoluteTime", ClaimPredicateBeforeAbsoluteTime(time.Unix(2000000000, 0).UTC())},
+		{"BeforeRelativeTime", ClaimPredicateBeforeRelativeTime(10 * time.Second)},
+		{"Not", ClaimPredicateNot(ClaimPredicateBeforeRelativeTime(3 * time.Second))},
+		{"And", ClaimPredicateAnd(
+			ClaimPredicateBeforeRelativeTime(10*time.Second),
+			ClaimPredicateUnconditional(),
+		)},
+		{"Or", ClaimPredicateOr(
+			ClaimPredicateBeforeRelativeTime(10*time.Second),
+			ClaimPredicateUnconditional(),
+		)},
+		{"Nested", ClaimPredicateAnd(
+			ClaimPredicateOr(
+				ClaimPredicateBeforeAbsoluteTime(time.Unix(2000000000, 0).UTC()),
+				ClaimPredicateNot(ClaimPredicateUnconditional()),
+			),
+			ClaimPredicateBeforeRelativeTime(5*time.Second),
+		)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			xdrPredicate, err := c.p.toXDR()
+			assert.NoError(t, err)
+
+			result, err := claimPredicateFromXDR(xdrPredicate)
+			assert.NoError(t, err)
+			assertPredicateEqual(t, c.p, result)
+		})
+	}
+}
+
+func TestCreateClaimableBalanceXDRRoundTrip(t *testing.T) {
+	cb := &CreateClaimableBalance{
+		SourceAccount: newKeypair0().Address(),
+		Amount:        "10.0000000",
+		Asset:         NativeAsset{},
+		Destinations: []Claimant{
+			NewClaimant(newKeypair1().Address(), nil),
+			NewClaimant(newKeypair2().Address(), predicatePtr(ClaimPredicateAnd(
+				ClaimPredicateBeforeRelativeTime(10*time.Second),
+				ClaimPredicateOr(
+					ClaimPredicateBeforeAbsoluteTime(time.Unix(2000000000, 0).UTC()),
+					ClaimPredicateNot(ClaimPredicateUnconditional()),
+				),
+			))),
+		},
+	}
+
+	xdrOp, err := cb.BuildXDR()
+	assert.NoError(t, err)
+
+	var result CreateClaimableBalance
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, cb.Amount, result.Amount)
+	assert.Equal(t, cb.Asset.CanonicalString(), result.Asset.CanonicalString())
+
+	if assert.Len(t, result.Destinations, len(cb.Destinations)) {
+		for i, claimant := range cb.Destinations {
+			assert.Equal(t, claimant.Destination, result.Destinations[i].Destination)
+			assertPredicateEqual(t, claimant.Predicate, result.Destinations[i].Predicate)
+		}
+	}
+}
+
+func TestCreateClaimableBalanceValidate(t *testing.T) {
+	cb := &CreateClaimableBalance{
+		SourceAccount: newKeypair0().Address(),
+		Amount:        "10",
+		Asset:         NativeAsset{},
+		Destinations: []Claimant{
+			NewClaimant(newKeypair1().Address(), nil),
+		},
+	}
+	assert.NoError(t, cb.Validate())
+
+	cb.Destinations = nil
+	err := cb.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Destinations")
+	}
+}
@@ -0,0 +1,164 @@
+package txnbuild
+
+import (
+	"fmt"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// Claimant pairs a destination account with the ClaimPredicate that must be satisfied for that
+// account to claim the balance.
+type Claimant struct {
+	Destination string
+	Predicate   ClaimPredicate
+}
+
+// NewClaimant returns a Claimant for destination that can claim unconditionally, unless a
+// predicate is supplied.
+func NewClaimant(destination string, predicate *ClaimPredicate) Claimant {
+	if predicate == nil {
+		unconditional := ClaimPredicateUnconditional()
+		predicate = &unconditional
+	}
+	return Claimant{Destination: destination, Predicate: *predicate}
+}
+
+func (c Claimant) toXDR() (xdr.Claimant, error) {
+	if err := c.Predicate.Validate(); err != nil {
+		return xdr.Claimant{}, err
+	}
+
+	var accountID xdr.AccountId
+	if err := accountID.SetAddress(c.Destination); err != nil {
+		return xdr.Claimant{}, errors.Wrap(err, "failed to set destination address")
+	}
+
+	xdrPredicate, err := c.Predicate.toXDR()
+	if err != nil {
+		return xdr.Claimant{}, errors.Wrap(err, "failed to convert predicate to xdr")
+	}
+
+	return xdr.NewClaimant(xdr.ClaimantTypeClaimantTypeV0, xdr.ClaimantV0{
+		Destination: accountID,
+		Predicate:   xdrPredicate,
+	})
+}
+
+func claimantFromXDR(xdrClaimant xdr.Claimant) (Claimant, error) {
+	v0, ok := xdrClaimant.GetV0()
+	if !ok {
+		return Claimant{}, errors.New("unsupported claimant type")
+	}
+
+	predicate, err := claimPredicateFromXDR(v0.Predicate)
+	if err != nil {
+		return Claimant{}, errors.Wrap(err, "failed to parse predicate")
+	}
+
+	return Claimant{
+		Destination: v0.Destination.Address(),
+		Predicate:   predicate,
+	}, nil
+}
+
+// CreateClaimableBalance represents the Stellar create_claimable_balance operation. See
+// https://developers.stellar.org/docs/start/list-of-operations/#create-claimable-balance
+type CreateClaimableBalance struct {
+	SourceAccount string
+	Amount        string
+	Asset         Asset
+	Destinations  []Claimant
+}
+
+// BuildXDR for CreateClaimableBalance returns a fully configured XDR Operation.
+func (cb *CreateClaimableBalance) BuildXDR() (xdr.Operation, error) {
+	xdrAsset, err := cb.Asset.ToXDR()
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to convert asset to xdr")
+	}
+
+	xdrAmount, err := amountToXDRInt64(cb.Amount)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse Amount")
+	}
+
+	xdrClaimants := make([]xdr.Claimant, len(cb.Destinations))
+	for i, claimant := range cb.Destinations {
+		xdrClaimant, err := claimant.toXDR()
+		if err != nil {
+			return xdr.Operation{}, errors.Wrapf(err, "failed to convert claimant %d to xdr", i)
+		}
+		xdrClaimants[i] = xdrClaimant
+	}
+
+	xdrOp := xdr.CreateClaimableBalanceOp{
+		Asset:     xdrAsset,
+		Amount:    xdrAmount,
+		Claimants: xdrClaimants,
+	}
+
+	body, err := xdr.NewOperationBody(xdr.OperationTypeCreateClaimableBalance, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, cb.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for CreateClaimableBalance populates the receiver with information from the supplied XDR Operation.
+func (cb *CreateClaimableBalance) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetCreateClaimableBalanceOp()
+	if !ok {
+		return errors.New("error parsing create_claimable_balance operation from xdr")
+	}
+
+	asset, err := assetFromXDR(result.Asset)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse asset")
+	}
+
+	claimants := make([]Claimant, len(result.Claimants))
+	for i, xdrClaimant := range result.Claimants {
+		claimant, err := claimantFromXDR(xdrClaimant)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse claimant %d", i)
+		}
+		claimants[i] = claimant
+	}
+
+	cb.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+	cb.Asset = asset
+	cb.Amount = amountFromXDRInt64(result.Amount)
+	cb.Destinations = claimants
+	return nil
+}
+
+// Validate for CreateClaimableBalance validates the required struct fields.
+func (cb *CreateClaimableBalance) Validate() error {
+	if err := validateStellarAsset(cb.Asset); err != nil {
+		return NewValidationError("Asset", err.Error())
+	}
+	if err := validateAmount(cb.Amount); err != nil {
+		return NewValidationError("Amount", err.Error())
+	}
+	if len(cb.Destinations) == 0 {
+		return NewValidationError("Destinations", "must contain at least one claimant")
+	}
+	for i, claimant := range cb.Destinations {
+		if err := validateStellarPublicKey(claimant.Destination); err != nil {
+			return NewValidationError(fmt.Sprintf("Destinations[%d].Destination", i), err.Error())
+		}
+		if err := claimant.Predicate.Validate(); err != nil {
+			return NewValidationError(fmt.Sprintf("Destinations[%d].Predicate", i), err.Error())
+		}
+	}
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation.
+func (cb *CreateClaimableBalance) GetSourceAccount() string {
+	return cb.SourceAccount
+}
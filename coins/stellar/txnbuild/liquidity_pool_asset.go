@@ -0,0 +1,141 @@
+package txnbuild
+
+import (
+	"fmt"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// LiquidityPoolFeeV18 is the only fee (in basis points) supported for liquidity pools as of
+// CAP-38 / protocol 18.
+const LiquidityPoolFeeV18 = int32(30)
+
+// LiquidityPoolAsset represents the asset pair underlying a CAP-38 constant-product liquidity
+// pool. AssetA must be lexicographically less than AssetB, as is required by stellar-core.
+type LiquidityPoolAsset struct {
+	AssetA Asset
+	AssetB Asset
+	Fee    int32
+}
+
+// NewLiquidityPoolShareChangeTrustAsset builds a ChangeTrustAsset for a pool-share trustline to
+// the constant-product pool for the given asset pair and fee. The assets are kept in the order
+// given; a pair that is not lex-ordered (a < b) is rejected by Validate, not reordered.
+func NewLiquidityPoolShareChangeTrustAsset(a, b Asset, fee int32) (ChangeTrustAsset, error) {
+	lpa := LiquidityPoolAsset{AssetA: a, AssetB: b, Fee: fee}
+	if err := lpa.Validate(); err != nil {
+		return nil, err
+	}
+	return &lpa, nil
+}
+
+// Validate checks that the pool asset pair is lex-ordered (AssetA < AssetB), the fee equals
+// LiquidityPoolFeeV18, and both assets are valid.
+func (lpa *LiquidityPoolAsset) Validate() error {
+	if err := validateStellarAsset(lpa.AssetA); err != nil {
+		return errors.Wrap(err, "AssetA is invalid")
+	}
+	if err := validateStellarAsset(lpa.AssetB); err != nil {
+		return errors.Wrap(err, "AssetB is invalid")
+	}
+
+	xdrAssetA, err := lpa.AssetA.ToXDR()
+	if err != nil {
+		return errors.Wrap(err, "failed to convert AssetA to XDR")
+	}
+	xdrAssetB, err := lpa.AssetB.ToXDR()
+	if err != nil {
+		return errors.Wrap(err, "failed to convert AssetB to XDR")
+	}
+	if !xdrAssetA.LessThan(xdrAssetB) {
+		return errors.New("AssetA must be lexicographically less than AssetB")
+	}
+
+	if lpa.Fee != LiquidityPoolFeeV18 {
+		return errors.Errorf("Fee must be %d", LiquidityPoolFeeV18)
+	}
+
+	return nil
+}
+
+// PoolID derives the PoolId for this asset pair and fee, as defined by CAP-38.
+func (lpa *LiquidityPoolAsset) PoolID() (string, error) {
+	if err := lpa.Validate(); err != nil {
+		return "", err
+	}
+
+	xdrAssetA, err := lpa.AssetA.ToXDR()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert AssetA to XDR")
+	}
+	xdrAssetB, err := lpa.AssetB.ToXDR()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert AssetB to XDR")
+	}
+
+	params := xdr.LiquidityPoolParameters{
+		Type: xdr.LiquidityPoolTypeLiquidityPoolConstantProduct,
+		ConstantProduct: &xdr.LiquidityPoolConstantProductParameters{
+			AssetA: xdrAssetA,
+			AssetB: xdrAssetB,
+			Fee:    xdr.Int32(lpa.Fee),
+		},
+	}
+
+	poolID, err := xdr.NewPoolId(params.ConstantProduct.AssetA, params.ConstantProduct.AssetB, params.ConstantProduct.Fee)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to derive liquidity pool id")
+	}
+
+	return poolID.HexString(), nil
+}
+
+// CanonicalString renders the pool-share trustline as "liquidity_pool:<assetA>:<assetB>:<fee>",
+// a reversible reference that lets ParseChangeTrustAssetString recover the exact asset pair and
+// fee (unlike the pool ID alone, which is a one-way hash of them).
+func (lpa *LiquidityPoolAsset) CanonicalString() string {
+	return fmt.Sprintf("liquidity_pool:%s:%s:%d", lpa.AssetA.CanonicalString(), lpa.AssetB.CanonicalString(), lpa.Fee)
+}
+
+// GetType for LiquidityPoolAsset returns the enum type used to indicate this is a pool share asset.
+func (lpa *LiquidityPoolAsset) GetType() (AssetType, error) {
+	return AssetTypePoolShare, nil
+}
+
+// IsNative for LiquidityPoolAsset returns false, since pool share assets are never native.
+func (lpa *LiquidityPoolAsset) IsNative() bool { return false }
+
+// GetCode for LiquidityPoolAsset returns the empty string; pool share trustlines have no code.
+func (lpa *LiquidityPoolAsset) GetCode() string { return "" }
+
+// GetIssuer for LiquidityPoolAsset returns the empty string; pool share trustlines have no issuer.
+func (lpa *LiquidityPoolAsset) GetIssuer() string { return "" }
+
+// ToXDR for LiquidityPoolAsset builds the XDR ChangeTrustAsset representing this pool share asset.
+func (lpa *LiquidityPoolAsset) ToXDR() (xdr.ChangeTrustAsset, error) {
+	if err := lpa.Validate(); err != nil {
+		return xdr.ChangeTrustAsset{}, err
+	}
+
+	xdrAssetA, err := lpa.AssetA.ToXDR()
+	if err != nil {
+		return xdr.ChangeTrustAsset{}, errors.Wrap(err, "failed to convert AssetA to XDR")
+	}
+	xdrAssetB, err := lpa.AssetB.ToXDR()
+	if err != nil {
+		return xdr.ChangeTrustAsset{}, errors.Wrap(err, "failed to convert AssetB to XDR")
+	}
+
+	return xdr.ChangeTrustAsset{
+		Type: xdr.AssetTypeAssetTypePoolShare,
+		LiquidityPool: &xdr.LiquidityPoolParameters{
+			Type: xdr.LiquidityPoolTypeLiquidityPoolConstantProduct,
+			ConstantProduct: &xdr.LiquidityPoolConstantProductParameters{
+				AssetA: xdrAssetA,
+				AssetB: xdrAssetB,
+				Fee:    xdr.Int32(lpa.Fee),
+			},
+		},
+	}, nil
+}
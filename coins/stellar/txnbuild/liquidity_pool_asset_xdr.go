@@ -0,0 +1,50 @@
+package txnbuild
+
+import (
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// liquidityPoolAssetFromXDR builds a ChangeTrustAsset from an xdr.ChangeTrustAsset of type
+// AssetTypeAssetTypePoolShare, recovering the underlying asset pair and fee.
+func liquidityPoolAssetFromXDR(xdrAsset xdr.ChangeTrustAsset) (ChangeTrustAsset, error) {
+	if xdrAsset.Type != xdr.AssetTypeAssetTypePoolShare {
+		return nil, errors.New("xdr asset is not a pool share asset")
+	}
+	if xdrAsset.LiquidityPool == nil || xdrAsset.LiquidityPool.ConstantProduct == nil {
+		return nil, errors.New("xdr asset is missing liquidity pool parameters")
+	}
+
+	params := xdrAsset.LiquidityPool.ConstantProduct
+
+	assetA, err := assetFromXDR(params.AssetA)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse AssetA")
+	}
+	assetB, err := assetFromXDR(params.AssetB)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse AssetB")
+	}
+
+	return &LiquidityPoolAsset{
+		AssetA: assetA,
+		AssetB: assetB,
+		Fee:    int32(params.Fee),
+	}, nil
+}
+
+// changeTrustAssetFromXDR builds a ChangeTrustAsset from an xdr.ChangeTrustAsset of any type,
+// recognizing the AssetTypeAssetTypePoolShare variant in addition to native/credit assets. This is
+// the entry point ChangeTrust.FromXDR uses to decode its Line field, so that a trustline to a
+// CAP-38 constant-product pool round-trips to a *LiquidityPoolAsset rather than failing to parse.
+func changeTrustAssetFromXDR(xdrAsset xdr.ChangeTrustAsset) (ChangeTrustAsset, error) {
+	if xdrAsset.Type == xdr.AssetTypeAssetTypePoolShare {
+		return liquidityPoolAssetFromXDR(xdrAsset)
+	}
+
+	asset, err := assetFromXDR(xdrAsset.ToAsset())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse asset")
+	}
+	return asset.ToChangeTrustAsset()
+}
@@ -0,0 +1,100 @@
+package txnbuild
+
+import (
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// LiquidityPoolDeposit represents the Stellar liquidity pool deposit operation. See CAP-38.
+type LiquidityPoolDeposit struct {
+	SourceAccount   string
+	LiquidityPoolID string
+	MaxAmountA      string
+	MaxAmountB      string
+	MinPrice        string
+	MaxPrice        string
+}
+
+// BuildXDR for LiquidityPoolDeposit returns a fully configured XDR Operation.
+func (lpd *LiquidityPoolDeposit) BuildXDR() (xdr.Operation, error) {
+	var poolID xdr.PoolId
+	if err := poolID.SetHex(lpd.LiquidityPoolID); err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to set liquidity pool id")
+	}
+
+	maxAmountA, err := amountToXDRInt64(lpd.MaxAmountA)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse MaxAmountA")
+	}
+	maxAmountB, err := amountToXDRInt64(lpd.MaxAmountB)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse MaxAmountB")
+	}
+
+	minPrice, err := priceToXDR(lpd.MinPrice)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse MinPrice")
+	}
+	maxPrice, err := priceToXDR(lpd.MaxPrice)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse MaxPrice")
+	}
+
+	xdrOp := xdr.LiquidityPoolDepositOp{
+		LiquidityPoolId: poolID,
+		MaxAmountA:      maxAmountA,
+		MaxAmountB:      maxAmountB,
+		MinPrice:        minPrice,
+		MaxPrice:        maxPrice,
+	}
+
+	body, err := xdr.NewOperationBody(xdr.OperationTypeLiquidityPoolDeposit, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, lpd.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for LiquidityPoolDeposit populates the receiver with information from the supplied XDR Operation.
+func (lpd *LiquidityPoolDeposit) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetLiquidityPoolDepositOp()
+	if !ok {
+		return errors.New("error parsing liquidity_pool_deposit operation from xdr")
+	}
+
+	lpd.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+	lpd.LiquidityPoolID = result.LiquidityPoolId.HexString()
+	lpd.MaxAmountA = amountFromXDRInt64(result.MaxAmountA)
+	lpd.MaxAmountB = amountFromXDRInt64(result.MaxAmountB)
+	lpd.MinPrice = priceFromXDR(result.MinPrice)
+	lpd.MaxPrice = priceFromXDR(result.MaxPrice)
+	return nil
+}
+
+// Validate for LiquidityPoolDeposit validates the required struct fields.
+func (lpd *LiquidityPoolDeposit) Validate() error {
+	if lpd.LiquidityPoolID == "" {
+		return NewValidationError("LiquidityPoolID", "is undefined")
+	}
+	if err := validateAmount(lpd.MaxAmountA); err != nil {
+		return NewValidationError("MaxAmountA", err.Error())
+	}
+	if err := validateAmount(lpd.MaxAmountB); err != nil {
+		return NewValidationError("MaxAmountB", err.Error())
+	}
+	if err := validateAmount(lpd.MinPrice); err != nil {
+		return NewValidationError("MinPrice", err.Error())
+	}
+	if err := validateAmount(lpd.MaxPrice); err != nil {
+		return NewValidationError("MaxPrice", err.Error())
+	}
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation.
+func (lpd *LiquidityPoolDeposit) GetSourceAccount() string {
+	return lpd.SourceAccount
+}
@@ -0,0 +1,168 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiquidityPoolAssetValidateOrdering(t *testing.T) {
+	assetA := CreditAsset{Code: "ABCD", Issuer: newKeypair0().Address()}
+	assetB := NativeAsset{}
+
+	// native sorts before any credit asset, so (assetB, assetA) is the correctly ordered pair.
+	_, err := NewLiquidityPoolShareChangeTrustAsset(assetA, assetB, LiquidityPoolFeeV18)
+	assert.Error(t, err)
+
+	lpa, err := NewLiquidityPoolShareChangeTrustAsset(assetB, assetA, LiquidityPoolFeeV18)
+	assert.NoError(t, err)
+	assert.NotNil(t, lpa)
+}
+
+func TestLiquidityPoolAssetValidateFee(t *testing.T) {
+	assetA := NativeAsset{}
+	assetB := CreditAsset{Code: "ABCD", Issuer: newKeypair0().Address()}
+
+	_, err := NewLiquidityPoolShareChangeTrustAsset(assetA, assetB, 100)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Fee must be")
+	}
+}
+
+func TestLiquidityPoolDepositValidate(t *testing.T) {
+	lpd := &LiquidityPoolDeposit{
+		SourceAccount:   newKeypair0().Address(),
+		LiquidityPoolID: "0000000029d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b8",
+		MaxAmountA:      "10",
+		MaxAmountB:      "20",
+		MinPrice:        "0.5",
+		MaxPrice:        "2",
+	}
+	assert.NoError(t, lpd.Validate())
+}
+
+func TestLiquidityPoolWithdrawValidate(t *testing.T) {
+	lpw := &LiquidityPoolWithdraw{
+		SourceAccount:   newKeypair0().Address(),
+		LiquidityPoolID: "0000000029d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b8",
+		Amount:          "10",
+		MinAmountA:      "1",
+		MinAmountB:      "1",
+	}
+	assert.NoError(t, lpw.Validate())
+
+	lpw.LiquidityPoolID = ""
+	err := lpw.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "LiquidityPoolID")
+	}
+}
+
+func TestLiquidityPoolDepositRoundTrip(t *testing.T) {
+	lpd := &LiquidityPoolDeposit{
+		SourceAccount:   newKeypair0().Address(),
+		LiquidityPoolID: "29d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b80",
+		MaxAmountA:      "10.0000000",
+		MaxAmountB:      "20.0000000",
+		MinPrice:        "0.5000000",
+		MaxPrice:        "2.0000000",
+	}
+
+	xdrOp, err := lpd.BuildXDR()
+	assert.NoError(t, err)
+
+	var result LiquidityPoolDeposit
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, lpd.LiquidityPoolID, result.LiquidityPoolID)
+	assert.Equal(t, lpd.MaxAmountA, result.MaxAmountA)
+	assert.Equal(t, lpd.MaxAmountB, result.MaxAmountB)
+	assert.Equal(t, lpd.MinPrice, result.MinPrice)
+	assert.Equal(t, lpd.MaxPrice, result.MaxPrice)
+}
+
+func TestLiquidityPoolWithdrawRoundTrip(t *testing.T) {
+	lpw := &LiquidityPoolWithdraw{
+		SourceAccount:   newKeypair0().Address(),
+		LiquidityPoolID: "29d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b80",
+		Amount:          "10.0000000",
+		MinAmountA:      "1.0000000",
+		MinAmountB:      "1.0000000",
+	}
+
+	xdrOp, err := lpw.BuildXDR()
+	assert.NoError(t, err)
+
+	var result LiquidityPoolWithdraw
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, lpw.LiquidityPoolID, result.LiquidityPoolID)
+	assert.Equal(t, lpw.Amount, result.Amount)
+	assert.Equal(t, lpw.MinAmountA, result.MinAmountA)
+	assert.Equal(t, lpw.MinAmountB, result.MinAmountB)
+}
+
+func TestLiquidityPoolAssetPoolID(t *testing.T) {
+	lpa := &LiquidityPoolAsset{
+		AssetA: NativeAsset{},
+		AssetB: CreditAsset{Code: "ABCD", Issuer: newKeypair0().Address()},
+		Fee:    LiquidityPoolFeeV18,
+	}
+
+	id, err := lpa.PoolID()
+	assert.NoError(t, err)
+	assert.Len(t, id, 64)
+
+	// PoolID is a pure function of the asset pair and fee: recomputing it is stable, and swapping
+	// the pair breaks the lex ordering, so no ID is derived for it.
+	again, err := lpa.PoolID()
+	assert.NoError(t, err)
+	assert.Equal(t, id, again)
+
+	swapped := &LiquidityPoolAsset{AssetA: lpa.AssetB, AssetB: lpa.AssetA, Fee: lpa.Fee}
+	_, err = swapped.PoolID()
+	assert.Error(t, err, "swapped pair is no longer lex-ordered and should fail Validate")
+}
+
+func TestChangeTrustPoolShareRoundTrip(t *testing.T) {
+	lpa := &LiquidityPoolAsset{
+		AssetA: NativeAsset{},
+		AssetB: CreditAsset{Code: "ABCD", Issuer: newKeypair0().Address()},
+		Fee:    LiquidityPoolFeeV18,
+	}
+	ct := &ChangeTrust{
+		SourceAccount: newKeypair0().Address(),
+		Line:          lpa,
+		Limit:         "922337203685.4775807",
+	}
+
+	xdrOp, err := ct.BuildXDR()
+	assert.NoError(t, err)
+
+	var result ChangeTrust
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, ct.Limit, result.Limit)
+
+	resultLPA, ok := result.Line.(*LiquidityPoolAsset)
+	if assert.True(t, ok, "expected a *LiquidityPoolAsset") {
+		assert.Equal(t, lpa.AssetA.CanonicalString(), resultLPA.AssetA.CanonicalString())
+		assert.Equal(t, lpa.AssetB.CanonicalString(), resultLPA.AssetB.CanonicalString())
+		assert.Equal(t, lpa.Fee, resultLPA.Fee)
+	}
+}
+
+func TestParseChangeTrustAssetStringPoolShareRoundTrip(t *testing.T) {
+	lpa := &LiquidityPoolAsset{
+		AssetA: NativeAsset{},
+		AssetB: CreditAsset{Code: "ABCD", Issuer: newKeypair0().Address()},
+		Fee:    LiquidityPoolFeeV18,
+	}
+
+	parsed, err := ParseChangeTrustAssetString(lpa.CanonicalString())
+	assert.NoError(t, err)
+
+	parsedLPA, ok := parsed.(*LiquidityPoolAsset)
+	if assert.True(t, ok, "expected a *LiquidityPoolAsset") {
+		assert.Equal(t, lpa.AssetA.CanonicalString(), parsedLPA.AssetA.CanonicalString())
+		assert.Equal(t, lpa.AssetB.CanonicalString(), parsedLPA.AssetB.CanonicalString())
+		assert.Equal(t, lpa.Fee, parsedLPA.Fee)
+	}
+}
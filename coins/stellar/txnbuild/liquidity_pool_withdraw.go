@@ -0,0 +1,89 @@
+package txnbuild
+
+import (
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// LiquidityPoolWithdraw represents the Stellar liquidity pool withdraw operation. See CAP-38.
+type LiquidityPoolWithdraw struct {
+	SourceAccount   string
+	LiquidityPoolID string
+	Amount          string
+	MinAmountA      string
+	MinAmountB      string
+}
+
+// BuildXDR for LiquidityPoolWithdraw returns a fully configured XDR Operation.
+func (lpw *LiquidityPoolWithdraw) BuildXDR() (xdr.Operation, error) {
+	var poolID xdr.PoolId
+	if err := poolID.SetHex(lpw.LiquidityPoolID); err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to set liquidity pool id")
+	}
+
+	amount, err := amountToXDRInt64(lpw.Amount)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse Amount")
+	}
+	minAmountA, err := amountToXDRInt64(lpw.MinAmountA)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse MinAmountA")
+	}
+	minAmountB, err := amountToXDRInt64(lpw.MinAmountB)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to parse MinAmountB")
+	}
+
+	xdrOp := xdr.LiquidityPoolWithdrawOp{
+		LiquidityPoolId: poolID,
+		Amount:          amount,
+		MinAmountA:      minAmountA,
+		MinAmountB:      minAmountB,
+	}
+
+	body, err := xdr.NewOperationBody(xdr.OperationTypeLiquidityPoolWithdraw, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, lpw.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for LiquidityPoolWithdraw populates the receiver with information from the supplied XDR Operation.
+func (lpw *LiquidityPoolWithdraw) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetLiquidityPoolWithdrawOp()
+	if !ok {
+		return errors.New("error parsing liquidity_pool_withdraw operation from xdr")
+	}
+
+	lpw.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+	lpw.LiquidityPoolID = result.LiquidityPoolId.HexString()
+	lpw.Amount = amountFromXDRInt64(result.Amount)
+	lpw.MinAmountA = amountFromXDRInt64(result.MinAmountA)
+	lpw.MinAmountB = amountFromXDRInt64(result.MinAmountB)
+	return nil
+}
+
+// Validate for LiquidityPoolWithdraw validates the required struct fields.
+func (lpw *LiquidityPoolWithdraw) Validate() error {
+	if lpw.LiquidityPoolID == "" {
+		return NewValidationError("LiquidityPoolID", "is undefined")
+	}
+	if err := validateAmount(lpw.Amount); err != nil {
+		return NewValidationError("Amount", err.Error())
+	}
+	if err := validateAmount(lpw.MinAmountA); err != nil {
+		return NewValidationError("MinAmountA", err.Error())
+	}
+	if err := validateAmount(lpw.MinAmountB); err != nil {
+		return NewValidationError("MinAmountB", err.Error())
+	}
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation.
+func (lpw *LiquidityPoolWithdraw) GetSourceAccount() string {
+	return lpw.SourceAccount
+}
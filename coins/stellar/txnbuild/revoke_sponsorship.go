@@ -0,0 +1,343 @@
+package txnbuild
+
+import (
+	"fmt"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// RevokeSponsorshipType represents the sub-type of ledger entry (or signer) a RevokeSponsorship
+// operation revokes sponsorship of.
+type RevokeSponsorshipType int
+
+// RevokeSponsorshipType values.
+const (
+	RevokeSponsorshipTypeAccount RevokeSponsorshipType = iota
+	RevokeSponsorshipTypeTrustLine
+	RevokeSponsorshipTypeOffer
+	RevokeSponsorshipTypeData
+	RevokeSponsorshipTypeClaimableBalance
+	RevokeSponsorshipTypeSigner
+	RevokeSponsorshipTypeLiquidityPool
+)
+
+// TrustLineID identifies a trustline ledger entry by its owning account and asset.
+type TrustLineID struct {
+	Account string
+	Asset   Asset
+}
+
+// OfferID identifies an offer ledger entry by its owning account and offer ID.
+type OfferID struct {
+	SellerID string
+	OfferID  int64
+}
+
+// DataID identifies a data ledger entry by its owning account and entry name.
+type DataID struct {
+	Account  string
+	DataName string
+}
+
+// SignerID identifies a signer on an account by the account ID and the signer's key.
+type SignerID struct {
+	AccountID string
+	SignerKey string
+}
+
+// RevokeSponsorship represents the Stellar revoke sponsorship operation. See
+// https://developers.stellar.org/docs/start/list-of-operations/#revoke-sponsorship
+//
+// Exactly one of Account, TrustLine, Offer, Data, ClaimableBalanceID, Signer or LiquidityPoolID
+// must be set; Type identifies which one.
+type RevokeSponsorship struct {
+	SourceAccount      string
+	Type               RevokeSponsorshipType
+	Account            *string
+	TrustLine          *TrustLineID
+	Offer              *OfferID
+	Data               *DataID
+	ClaimableBalanceID *string
+	Signer             *SignerID
+	LiquidityPoolID    *string
+}
+
+// BuildXDR for RevokeSponsorship returns a fully configured XDR Operation.
+func (rs *RevokeSponsorship) BuildXDR() (xdr.Operation, error) {
+	ledgerKey := xdr.LedgerKey{}
+	signer := xdr.RevokeSponsorshipOpSigner{}
+	isSigner := false
+
+	switch rs.Type {
+	case RevokeSponsorshipTypeAccount:
+		if rs.Account == nil {
+			return xdr.Operation{}, errors.New("Account is required for RevokeSponsorshipTypeAccount")
+		}
+		var accountID xdr.AccountId
+		if err := accountID.SetAddress(*rs.Account); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set account id address")
+		}
+		if err := ledgerKey.SetAccount(accountID.Address()); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set ledger key account")
+		}
+
+	case RevokeSponsorshipTypeTrustLine:
+		if rs.TrustLine == nil {
+			return xdr.Operation{}, errors.New("TrustLine is required for RevokeSponsorshipTypeTrustLine")
+		}
+		xdrAsset, err := rs.TrustLine.Asset.ToXDR()
+		if err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set XDR trustline asset")
+		}
+		trustLineAsset, err := xdrAsset.ToTrustLineAsset()
+		if err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to convert asset to trustline asset")
+		}
+		if err := ledgerKey.SetTrustline(rs.TrustLine.Account, trustLineAsset); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set ledger key trustline")
+		}
+
+	case RevokeSponsorshipTypeOffer:
+		if rs.Offer == nil {
+			return xdr.Operation{}, errors.New("Offer is required for RevokeSponsorshipTypeOffer")
+		}
+		if err := ledgerKey.SetOffer(rs.Offer.SellerID, rs.Offer.OfferID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set ledger key offer")
+		}
+
+	case RevokeSponsorshipTypeData:
+		if rs.Data == nil {
+			return xdr.Operation{}, errors.New("Data is required for RevokeSponsorshipTypeData")
+		}
+		if err := ledgerKey.SetData(rs.Data.Account, rs.Data.DataName); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set ledger key data")
+		}
+
+	case RevokeSponsorshipTypeClaimableBalance:
+		if rs.ClaimableBalanceID == nil {
+			return xdr.Operation{}, errors.New("ClaimableBalanceID is required for RevokeSponsorshipTypeClaimableBalance")
+		}
+		var balanceID xdr.ClaimableBalanceId
+		if err := balanceID.SetHex(*rs.ClaimableBalanceID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set claimable balance id")
+		}
+		if err := ledgerKey.SetClaimableBalance(balanceID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set ledger key claimable balance")
+		}
+
+	case RevokeSponsorshipTypeLiquidityPool:
+		if rs.LiquidityPoolID == nil {
+			return xdr.Operation{}, errors.New("LiquidityPoolID is required for RevokeSponsorshipTypeLiquidityPool")
+		}
+		var poolID xdr.PoolId
+		if err := poolID.SetHex(*rs.LiquidityPoolID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set liquidity pool id")
+		}
+		if err := ledgerKey.SetLiquidityPool(poolID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set ledger key liquidity pool")
+		}
+
+	case RevokeSponsorshipTypeSigner:
+		if rs.Signer == nil {
+			return xdr.Operation{}, errors.New("Signer is required for RevokeSponsorshipTypeSigner")
+		}
+		var accountID xdr.AccountId
+		if err := accountID.SetAddress(rs.Signer.AccountID); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set account id address")
+		}
+		var signerKey xdr.SignerKey
+		if err := signerKey.SetAddress(rs.Signer.SignerKey); err != nil {
+			return xdr.Operation{}, errors.Wrap(err, "failed to set signer key address")
+		}
+		signer = xdr.RevokeSponsorshipOpSigner{
+			AccountId: accountID,
+			SignerKey: signerKey,
+		}
+		isSigner = true
+
+	default:
+		return xdr.Operation{}, errors.New("unknown RevokeSponsorshipType")
+	}
+
+	revokeSponsorshipType := xdr.RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry
+	if isSigner {
+		revokeSponsorshipType = xdr.RevokeSponsorshipTypeRevokeSponsorshipSigner
+	}
+
+	xdrOp := xdr.RevokeSponsorshipOp{
+		Type:        revokeSponsorshipType,
+		LedgerKey:   &ledgerKey,
+		Signer:      &signer,
+	}
+	if isSigner {
+		xdrOp.LedgerKey = nil
+	} else {
+		xdrOp.Signer = nil
+	}
+
+	opType := xdr.OperationTypeRevokeSponsorship
+	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	SetOpSourceAccount(&op, rs.SourceAccount)
+	return op, nil
+}
+
+// FromXDR for RevokeSponsorship populates the receiver with information from the supplied XDR Operation.
+func (rs *RevokeSponsorship) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetRevokeSponsorshipOp()
+	if !ok {
+		return errors.New("error parsing revoke_sponsorship operation from xdr")
+	}
+
+	rs.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	if result.Type == xdr.RevokeSponsorshipTypeRevokeSponsorshipSigner {
+		if result.Signer == nil {
+			return errors.New("signer is required for RevokeSponsorshipTypeRevokeSponsorshipSigner")
+		}
+		rs.Type = RevokeSponsorshipTypeSigner
+		accountID := result.Signer.AccountId.Address()
+		signerKey, err := result.Signer.SignerKey.GetAddress()
+		if err != nil {
+			return errors.Wrap(err, "error parsing signer key")
+		}
+		rs.Signer = &SignerID{AccountID: accountID, SignerKey: signerKey}
+		return nil
+	}
+
+	if result.LedgerKey == nil {
+		return errors.New("ledger key is required for RevokeSponsorshipTypeRevokeSponsorshipLedgerEntry")
+	}
+
+	switch result.LedgerKey.Type {
+	case xdr.LedgerEntryTypeAccount:
+		rs.Type = RevokeSponsorshipTypeAccount
+		address := result.LedgerKey.Account.AccountId.Address()
+		rs.Account = &address
+
+	case xdr.LedgerEntryTypeTrustline:
+		rs.Type = RevokeSponsorshipTypeTrustLine
+		asset, err := assetFromTrustLineXDR(result.LedgerKey.TrustLine.Asset)
+		if err != nil {
+			return errors.Wrap(err, "error parsing trustline asset")
+		}
+		rs.TrustLine = &TrustLineID{
+			Account: result.LedgerKey.TrustLine.AccountId.Address(),
+			Asset:   asset,
+		}
+
+	case xdr.LedgerEntryTypeOffer:
+		rs.Type = RevokeSponsorshipTypeOffer
+		rs.Offer = &OfferID{
+			SellerID: result.LedgerKey.Offer.SellerId.Address(),
+			OfferID:  int64(result.LedgerKey.Offer.OfferId),
+		}
+
+	case xdr.LedgerEntryTypeData:
+		rs.Type = RevokeSponsorshipTypeData
+		rs.Data = &DataID{
+			Account:  result.LedgerKey.Data.AccountId.Address(),
+			DataName: string(result.LedgerKey.Data.DataName),
+		}
+
+	case xdr.LedgerEntryTypeClaimableBalance:
+		rs.Type = RevokeSponsorshipTypeClaimableBalance
+		id, err := result.LedgerKey.ClaimableBalance.BalanceId.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "error parsing claimable balance id")
+		}
+		hexID := fmt.Sprintf("%x", id)
+		rs.ClaimableBalanceID = &hexID
+
+	case xdr.LedgerEntryTypeLiquidityPool:
+		rs.Type = RevokeSponsorshipTypeLiquidityPool
+		id, err := result.LedgerKey.LiquidityPool.LiquidityPoolId.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "error parsing liquidity pool id")
+		}
+		hexID := fmt.Sprintf("%x", id)
+		rs.LiquidityPoolID = &hexID
+
+	default:
+		return errors.New("unknown ledger key type for revoke_sponsorship operation")
+	}
+
+	return nil
+}
+
+// Validate for RevokeSponsorship validates the required struct fields. It returns an error if any
+// of the fields are invalid. Otherwise, it returns nil.
+func (rs *RevokeSponsorship) Validate() error {
+	switch rs.Type {
+	case RevokeSponsorshipTypeAccount:
+		if rs.Account == nil {
+			return NewValidationError("Account", "is required for RevokeSponsorshipTypeAccount")
+		}
+		if err := validateStellarPublicKey(*rs.Account); err != nil {
+			return NewValidationError("Account", err.Error())
+		}
+
+	case RevokeSponsorshipTypeTrustLine:
+		if rs.TrustLine == nil {
+			return NewValidationError("TrustLine", "is required for RevokeSponsorshipTypeTrustLine")
+		}
+		if err := validateStellarPublicKey(rs.TrustLine.Account); err != nil {
+			return NewValidationError("TrustLine.Account", err.Error())
+		}
+		if err := validateStellarAsset(rs.TrustLine.Asset); err != nil {
+			return NewValidationError("TrustLine.Asset", err.Error())
+		}
+
+	case RevokeSponsorshipTypeOffer:
+		if rs.Offer == nil {
+			return NewValidationError("Offer", "is required for RevokeSponsorshipTypeOffer")
+		}
+		if err := validateStellarPublicKey(rs.Offer.SellerID); err != nil {
+			return NewValidationError("Offer.SellerID", err.Error())
+		}
+
+	case RevokeSponsorshipTypeData:
+		if rs.Data == nil {
+			return NewValidationError("Data", "is required for RevokeSponsorshipTypeData")
+		}
+		if err := validateStellarPublicKey(rs.Data.Account); err != nil {
+			return NewValidationError("Data.Account", err.Error())
+		}
+
+	case RevokeSponsorshipTypeClaimableBalance:
+		if rs.ClaimableBalanceID == nil {
+			return NewValidationError("ClaimableBalanceID", "is required for RevokeSponsorshipTypeClaimableBalance")
+		}
+
+	case RevokeSponsorshipTypeLiquidityPool:
+		if rs.LiquidityPoolID == nil {
+			return NewValidationError("LiquidityPoolID", "is required for RevokeSponsorshipTypeLiquidityPool")
+		}
+
+	case RevokeSponsorshipTypeSigner:
+		if rs.Signer == nil {
+			return NewValidationError("Signer", "is required for RevokeSponsorshipTypeSigner")
+		}
+		if err := validateStellarPublicKey(rs.Signer.AccountID); err != nil {
+			return NewValidationError("Signer.AccountID", err.Error())
+		}
+		if err := validateStellarSignerKey(rs.Signer.SignerKey); err != nil {
+			return NewValidationError("Signer.SignerKey", err.Error())
+		}
+
+	default:
+		return NewValidationError("Type", "is invalid")
+	}
+
+	return nil
+}
+
+// GetSourceAccount returns the source account of the operation.
+func (rs *RevokeSponsorship) GetSourceAccount() string {
+	return rs.SourceAccount
+}
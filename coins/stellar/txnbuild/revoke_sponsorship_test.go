@@ -0,0 +1,214 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeSponsorshipAccountValidate(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeAccount,
+	}
+	err := rs.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Account")
+	}
+
+	account := newKeypair1().Address()
+	rs.Account = &account
+	assert.NoError(t, rs.Validate())
+}
+
+func TestRevokeSponsorshipTrustLineValidate(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeTrustLine,
+		TrustLine: &TrustLineID{
+			Account: newKeypair1().Address(),
+			Asset: CreditAsset{
+				Code:   "ABCD",
+				Issuer: newKeypair2().Address(),
+			},
+		},
+	}
+	assert.NoError(t, rs.Validate())
+
+	rs.TrustLine.Account = "not-a-real-account"
+	err := rs.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "TrustLine.Account")
+	}
+}
+
+func TestRevokeSponsorshipOfferValidate(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeOffer,
+		Offer: &OfferID{
+			SellerID: newKeypair1().Address(),
+			OfferID:  1,
+		},
+	}
+	assert.NoError(t, rs.Validate())
+}
+
+func TestRevokeSponsorshipDataValidate(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeData,
+		Data: &DataID{
+			Account:  newKeypair1().Address(),
+			DataName: "test-data",
+		},
+	}
+	assert.NoError(t, rs.Validate())
+}
+
+func TestRevokeSponsorshipClaimableBalanceValidate(t *testing.T) {
+	id := "0000000029d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b8"
+	rs := &RevokeSponsorship{
+		SourceAccount:      newKeypair0().Address(),
+		Type:               RevokeSponsorshipTypeClaimableBalance,
+		ClaimableBalanceID: &id,
+	}
+	assert.NoError(t, rs.Validate())
+}
+
+func TestRevokeSponsorshipSignerValidate(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeSigner,
+		Signer: &SignerID{
+			AccountID: newKeypair1().Address(),
+			SignerKey: newKeypair2().Address(),
+		},
+	}
+	assert.NoError(t, rs.Validate())
+
+	rs.Signer.SignerKey = "not-a-real-signer"
+	err := rs.Validate()
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Signer.SignerKey")
+	}
+}
+
+func TestRevokeSponsorshipAccountRoundTrip(t *testing.T) {
+	account := newKeypair1().Address()
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeAccount,
+		Account:       &account,
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, *rs.Account, *result.Account)
+}
+
+func TestRevokeSponsorshipTrustLineRoundTrip(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeTrustLine,
+		TrustLine: &TrustLineID{
+			Account: newKeypair1().Address(),
+			Asset:   CreditAsset{Code: "ABCD", Issuer: newKeypair2().Address()},
+		},
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, rs.TrustLine.Account, result.TrustLine.Account)
+	assert.Equal(t, rs.TrustLine.Asset.CanonicalString(), result.TrustLine.Asset.CanonicalString())
+}
+
+func TestRevokeSponsorshipOfferRoundTrip(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeOffer,
+		Offer:         &OfferID{SellerID: newKeypair1().Address(), OfferID: 7},
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, *rs.Offer, *result.Offer)
+}
+
+func TestRevokeSponsorshipDataRoundTrip(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeData,
+		Data:          &DataID{Account: newKeypair1().Address(), DataName: "test-data"},
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, *rs.Data, *result.Data)
+}
+
+func TestRevokeSponsorshipClaimableBalanceRoundTrip(t *testing.T) {
+	id := "0000000029d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b80"
+	rs := &RevokeSponsorship{
+		SourceAccount:      newKeypair0().Address(),
+		Type:               RevokeSponsorshipTypeClaimableBalance,
+		ClaimableBalanceID: &id,
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, *rs.ClaimableBalanceID, *result.ClaimableBalanceID)
+}
+
+func TestRevokeSponsorshipLiquidityPoolRoundTrip(t *testing.T) {
+	id := "29d2bb36c57697b6244d6fe37b6cf9609a12483858730dfc3a6fb8cf109a3b80"
+	rs := &RevokeSponsorship{
+		SourceAccount:   newKeypair0().Address(),
+		Type:            RevokeSponsorshipTypeLiquidityPool,
+		LiquidityPoolID: &id,
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, *rs.LiquidityPoolID, *result.LiquidityPoolID)
+}
+
+func TestRevokeSponsorshipSignerRoundTrip(t *testing.T) {
+	rs := &RevokeSponsorship{
+		SourceAccount: newKeypair0().Address(),
+		Type:          RevokeSponsorshipTypeSigner,
+		Signer:        &SignerID{AccountID: newKeypair1().Address(), SignerKey: newKeypair2().Address()},
+	}
+
+	xdrOp, err := rs.BuildXDR()
+	assert.NoError(t, err)
+
+	var result RevokeSponsorship
+	assert.NoError(t, result.FromXDR(xdrOp))
+	assert.Equal(t, rs.Type, result.Type)
+	assert.Equal(t, *rs.Signer, *result.Signer)
+}
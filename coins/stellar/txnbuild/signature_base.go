@@ -0,0 +1,54 @@
+package txnbuild
+
+import (
+	"crypto/sha256"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+	"github.com/okx/go-wallet-sdk/coins/stellar/xdr"
+)
+
+// SignatureBase returns the SHA-256 pre-image that network signatures over tx are computed from:
+// the network ID followed by the XDR-encoded signed transaction payload. External signers (HSMs,
+// hardware wallets) can hash and sign this out-of-band and attach the resulting signature with
+// AddSignatureDecorated.
+func (tx *Transaction) SignatureBase(networkPassphrase string) ([]byte, error) {
+	if tx == nil {
+		return nil, errors.New("transaction is undefined")
+	}
+
+	taggedTx, err := tx.TaggedTransactionV1()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build tagged transaction")
+	}
+
+	networkID := xdr.Hash(sha256.Sum256([]byte(networkPassphrase)))
+	payload := xdr.TransactionSignaturePayload{
+		NetworkId:         networkID,
+		TaggedTransaction: taggedTx,
+	}
+
+	raw, err := payload.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal transaction signature payload")
+	}
+
+	return raw, nil
+}
+
+// AddSignatureDecorated attaches a signature produced out-of-band (e.g. by an HSM or hardware
+// signer over the pre-image returned by SignatureBase) to the transaction envelope. hint
+// identifies the signing key, typically the last 4 bytes of its public key.
+func (tx *Transaction) AddSignatureDecorated(hint [4]byte, sig []byte) (*Transaction, error) {
+	if tx == nil {
+		return nil, errors.New("transaction is undefined")
+	}
+
+	decorated := xdr.DecoratedSignature{
+		Hint:      xdr.SignatureHint(hint),
+		Signature: xdr.Signature(sig),
+	}
+
+	newTx := tx.clone()
+	newTx.signatures = append(newTx.signatures, decorated)
+	return newTx, nil
+}
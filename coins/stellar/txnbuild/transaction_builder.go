@@ -0,0 +1,127 @@
+package txnbuild
+
+import (
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+)
+
+// TransactionBuilder provides a fluent alternative to constructing a TransactionParams struct
+// literal and calling NewTransaction. Validation errors raised by the underlying helpers are
+// recorded as they occur, so the first Build() call reports the first field that failed rather
+// than an opaque downstream error.
+type TransactionBuilder struct {
+	params TransactionParams
+	err    error
+}
+
+// NewBuilder returns a TransactionBuilder for a transaction with the given source account.
+func NewBuilder(source Account) *TransactionBuilder {
+	return &TransactionBuilder{
+		params: TransactionParams{
+			SourceAccount:        source,
+			IncrementSequenceNum: true,
+		},
+	}
+}
+
+// AddOperation appends op to the transaction being built.
+func (b *TransactionBuilder) AddOperation(op Operation) *TransactionBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := op.Validate(); err != nil {
+		b.err = NewValidationError("Operations", err.Error())
+		return b
+	}
+	b.params.Operations = append(b.params.Operations, op)
+	return b
+}
+
+// SetTimebounds sets the transaction's validity window.
+func (b *TransactionBuilder) SetTimebounds(tb TimeBounds) *TransactionBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.params.Timebounds = tb
+	return b
+}
+
+// SetBaseFee sets the per-operation base fee, validated with the same helper used for amount fields.
+func (b *TransactionBuilder) SetBaseFee(baseFee int64) *TransactionBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := validateAmount(baseFee); err != nil {
+		b.err = NewValidationError("BaseFee", err.Error())
+		return b
+	}
+	b.params.BaseFee = baseFee
+	return b
+}
+
+// SetMemo sets the transaction memo.
+func (b *TransactionBuilder) SetMemo(memo Memo) *TransactionBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.params.Memo = memo
+	return b
+}
+
+// Build validates and assembles the final *Transaction. It returns the first error recorded
+// while the builder was configured, if any, otherwise it delegates to NewTransaction.
+func (b *TransactionBuilder) Build() (*Transaction, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	tx, err := NewTransaction(b.params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build transaction")
+	}
+	return tx, nil
+}
+
+// FeeBumpBuilder provides a fluent alternative to constructing a FeeBumpTransactionParams struct
+// literal and calling NewFeeBumpTransaction.
+type FeeBumpBuilder struct {
+	params FeeBumpTransactionParams
+	err    error
+}
+
+// NewFeeBumpBuilder returns a FeeBumpBuilder that wraps inner with a new fee-paying source account.
+func NewFeeBumpBuilder(feeSource string, inner *Transaction) *FeeBumpBuilder {
+	b := &FeeBumpBuilder{
+		params: FeeBumpTransactionParams{
+			Inner:      inner,
+			FeeAccount: feeSource,
+		},
+	}
+	if err := validateStellarPublicKey(feeSource); err != nil {
+		b.err = NewValidationError("FeeAccount", err.Error())
+	}
+	return b
+}
+
+// SetBaseFee sets the per-operation base fee paid by the fee-bump's outer envelope.
+func (b *FeeBumpBuilder) SetBaseFee(baseFee int64) *FeeBumpBuilder {
+	if b.err != nil {
+		return b
+	}
+	if err := validateAmount(baseFee); err != nil {
+		b.err = NewValidationError("BaseFee", err.Error())
+		return b
+	}
+	b.params.BaseFee = baseFee
+	return b
+}
+
+// Build validates and assembles the final *FeeBumpTransaction.
+func (b *FeeBumpBuilder) Build() (*FeeBumpTransaction, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	tx, err := NewFeeBumpTransaction(b.params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build fee-bump transaction")
+	}
+	return tx, nil
+}
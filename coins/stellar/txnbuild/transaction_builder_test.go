@@ -0,0 +1,97 @@
+package txnbuild
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionBuilderBuild(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), 9605939170639897)
+
+	tx, err := NewBuilder(&sourceAccount).
+		AddOperation(&Payment{
+			Destination: newKeypair1().Address(),
+			Amount:      "10",
+			Asset:       NativeAsset{},
+		}).
+		SetTimebounds(NewInfiniteTimeout()).
+		SetBaseFee(MinBaseFee).
+		Build()
+
+	assert.NoError(t, err)
+	assert.Len(t, tx.Operations(), 1)
+}
+
+func TestTransactionBuilderPropagatesOperationError(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), 9605939170639897)
+
+	_, err := NewBuilder(&sourceAccount).
+		AddOperation(&Payment{
+			Destination: "not-a-valid-address",
+			Amount:      "10",
+			Asset:       NativeAsset{},
+		}).
+		SetBaseFee(MinBaseFee).
+		Build()
+
+	assert.Error(t, err)
+}
+
+func TestFeeBumpBuilderBuild(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), 9605939170639897)
+
+	inner, err := NewBuilder(&sourceAccount).
+		AddOperation(&Payment{
+			Destination: newKeypair1().Address(),
+			Amount:      "10",
+			Asset:       NativeAsset{},
+		}).
+		SetTimebounds(NewInfiniteTimeout()).
+		SetBaseFee(MinBaseFee).
+		Build()
+	assert.NoError(t, err)
+
+	feeBump, err := NewFeeBumpBuilder(newKeypair2().Address(), inner).
+		SetBaseFee(200).
+		Build()
+
+	assert.NoError(t, err)
+	assert.NotNil(t, feeBump)
+}
+
+func TestSignatureBaseAndAddSignatureDecorated(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), 9605939170639897)
+
+	tx, err := NewBuilder(&sourceAccount).
+		AddOperation(&Payment{
+			Destination: newKeypair1().Address(),
+			Amount:      "10",
+			Asset:       NativeAsset{},
+		}).
+		SetTimebounds(NewInfiniteTimeout()).
+		SetBaseFee(MinBaseFee).
+		Build()
+	assert.NoError(t, err)
+
+	base, err := tx.SignatureBase(network.TestNetworkPassphrase)
+	assert.NoError(t, err)
+	// SignatureBase is the pre-image external signers hash themselves, not an already-hashed
+	// digest: network ID (32 bytes) followed by the XDR-encoded tagged transaction.
+	networkID := sha256.Sum256([]byte(network.TestNetworkPassphrase))
+	if assert.Greater(t, len(base), 32) {
+		assert.Equal(t, networkID[:], base[:32])
+	}
+
+	var hint [4]byte
+	copy(hint[:], kp0.Hint()[:])
+	signed, err := tx.AddSignatureDecorated(hint, []byte("fake-signature-bytes-000000000"))
+	assert.NoError(t, err)
+	assert.Len(t, signed.Signatures(), 1)
+}
@@ -0,0 +1,210 @@
+package txnbuild
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+)
+
+// txRepLine is a single "key: value" entry of a SEP-11 TxRep document.
+type txRepLine struct {
+	key   string
+	value string
+}
+
+// MarshalTxRep renders tx as a SEP-11 TxRep document: a human-readable, line-oriented annotation
+// of the XDR transaction envelope. See
+// https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0011.md
+func MarshalTxRep(tx *Transaction) (string, error) {
+	if tx == nil {
+		return "", errors.New("transaction is undefined")
+	}
+
+	var lines []txRepLine
+	lines = append(lines, txRepLine{"tx.sourceAccount", tx.SourceAccount().AccountID})
+	lines = append(lines, txRepLine{"tx.fee", strconv.FormatInt(int64(tx.BaseFee())*int64(len(tx.Operations())), 10)})
+	lines = append(lines, txRepLine{"tx.seqNum", strconv.FormatInt(tx.SourceAccount().Sequence, 10)})
+	lines = append(lines, marshalTimeBounds(tx.Timebounds())...)
+	lines = append(lines, marshalMemo(tx.Memo())...)
+
+	ops := tx.Operations()
+	lines = append(lines, txRepLine{"tx.operations.len", strconv.Itoa(len(ops))})
+	for i, op := range ops {
+		opLines, err := marshalOperation(i, op)
+		if err != nil {
+			return "", errors.Wrapf(err, "error marshaling operation %d", i)
+		}
+		lines = append(lines, opLines...)
+	}
+
+	var b strings.Builder
+	for _, l := range lines {
+		fmt.Fprintf(&b, "%s: %s\n", l.key, l.value)
+	}
+	return b.String(), nil
+}
+
+// UnmarshalTxRep parses a SEP-11 TxRep document produced by MarshalTxRep (or a compatible tool
+// such as stc) back into a Transaction.
+func UnmarshalTxRep(s string) (*Transaction, error) {
+	fields, err := parseTxRepFields(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing txrep document")
+	}
+
+	sourceAccount, ok := fields["tx.sourceAccount"]
+	if !ok {
+		return nil, errors.New("tx.sourceAccount is required")
+	}
+	if err := validateStellarPublicKey(sourceAccount); err != nil {
+		return nil, errors.Wrap(err, "tx.sourceAccount")
+	}
+
+	seqNum, err := strconv.ParseInt(fields["tx.seqNum"], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing tx.seqNum")
+	}
+
+	fee, err := strconv.ParseInt(fields["tx.fee"], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing tx.fee")
+	}
+
+	numOps, err := strconv.Atoi(fields["tx.operations.len"])
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing tx.operations.len")
+	}
+
+	ops := make([]Operation, 0, numOps)
+	for i := 0; i < numOps; i++ {
+		op, err := unmarshalOperation(i, fields)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing operation %d", i)
+		}
+		ops = append(ops, op)
+	}
+
+	memo, err := unmarshalMemo(fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing memo")
+	}
+
+	timebounds, err := unmarshalTimeBounds(fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing time bounds")
+	}
+
+	baseFee := fee
+	if numOps > 0 {
+		baseFee = fee / int64(numOps)
+	}
+
+	return NewTransaction(TransactionParams{
+		SourceAccount: &SimpleAccount{
+			AccountID: sourceAccount,
+			Sequence:  seqNum,
+		},
+		Operations:    ops,
+		BaseFee:       baseFee,
+		Memo:          memo,
+		Timebounds:    timebounds,
+		IncrementSequenceNum: false,
+	})
+}
+
+func parseTxRepFields(s string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("malformed txrep line: %q", line)
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+		// Strip a trailing SEP-11 comment, e.g. "tx.operations[0].type: PAYMENT (1)".
+		if idx := strings.LastIndex(value, " ("); idx > 0 && strings.HasSuffix(value, ")") {
+			value = value[:idx]
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+func marshalTimeBounds(tb TimeBounds) []txRepLine {
+	return []txRepLine{
+		{"tx.timeBounds.minTime", strconv.FormatInt(tb.MinTime, 10)},
+		{"tx.timeBounds.maxTime", strconv.FormatInt(tb.MaxTime, 10)},
+	}
+}
+
+func unmarshalTimeBounds(fields map[string]string) (TimeBounds, error) {
+	minTime, err := strconv.ParseInt(fields["tx.timeBounds.minTime"], 10, 64)
+	if err != nil {
+		return TimeBounds{}, errors.Wrap(err, "error parsing tx.timeBounds.minTime")
+	}
+	maxTime, err := strconv.ParseInt(fields["tx.timeBounds.maxTime"], 10, 64)
+	if err != nil {
+		return TimeBounds{}, errors.Wrap(err, "error parsing tx.timeBounds.maxTime")
+	}
+	return TimeBounds{MinTime: minTime, MaxTime: maxTime}, nil
+}
+
+func marshalMemo(memo Memo) []txRepLine {
+	switch m := memo.(type) {
+	case nil:
+		return []txRepLine{{"tx.memo.type", "MEMO_NONE"}}
+	case MemoText:
+		return []txRepLine{
+			{"tx.memo.type", "MEMO_TEXT"},
+			{"tx.memo.text", fmt.Sprintf("%q", string(m))},
+		}
+	case MemoID:
+		return []txRepLine{
+			{"tx.memo.type", "MEMO_ID"},
+			{"tx.memo.id", strconv.FormatUint(uint64(m), 10)},
+		}
+	case MemoHash:
+		return []txRepLine{
+			{"tx.memo.type", "MEMO_HASH"},
+			{"tx.memo.hash", fmt.Sprintf("%x", m)},
+		}
+	case MemoReturn:
+		return []txRepLine{
+			{"tx.memo.type", "MEMO_RETURN"},
+			{"tx.memo.retHash", fmt.Sprintf("%x", m)},
+		}
+	default:
+		return []txRepLine{{"tx.memo.type", "MEMO_NONE"}}
+	}
+}
+
+func unmarshalMemo(fields map[string]string) (Memo, error) {
+	switch fields["tx.memo.type"] {
+	case "", "MEMO_NONE":
+		return nil, nil
+	case "MEMO_TEXT":
+		text, err := strconv.Unquote(fields["tx.memo.text"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing tx.memo.text")
+		}
+		return MemoText(text), nil
+	case "MEMO_ID":
+		id, err := strconv.ParseUint(fields["tx.memo.id"], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return MemoID(id), nil
+	case "MEMO_HASH":
+		return MemoHashFromHexString(fields["tx.memo.hash"])
+	case "MEMO_RETURN":
+		return MemoReturnFromHexString(fields["tx.memo.retHash"])
+	default:
+		return nil, errors.Errorf("unknown memo type %q", fields["tx.memo.type"])
+	}
+}
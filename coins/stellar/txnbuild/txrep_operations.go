@@ -0,0 +1,713 @@
+package txnbuild
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okx/go-wallet-sdk/coins/stellar/support/errors"
+)
+
+// marshalOperation renders a single operation of a transaction as its SEP-11 TxRep lines, keyed
+// under tx.operations[i].
+func marshalOperation(i int, op Operation) ([]txRepLine, error) {
+	prefix := fmt.Sprintf("tx.operations[%d].body", i)
+	var lines []txRepLine
+
+	if src := op.GetSourceAccount(); src != "" {
+		lines = append(lines, txRepLine{fmt.Sprintf("tx.operations[%d].sourceAccount", i), src})
+	}
+
+	switch o := op.(type) {
+	case *Payment:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "PAYMENT"},
+			txRepLine{prefix + ".paymentOp.destination", o.Destination},
+			txRepLine{prefix + ".paymentOp.asset", canonicalAssetString(o.Asset)},
+			txRepLine{prefix + ".paymentOp.amount", o.Amount},
+		)
+	case *CreateAccount:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "CREATE_ACCOUNT"},
+			txRepLine{prefix + ".createAccountOp.destination", o.Destination},
+			txRepLine{prefix + ".createAccountOp.startingBalance", o.Amount},
+		)
+	case *ManageSellOffer:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "MANAGE_SELL_OFFER"},
+			txRepLine{prefix + ".manageSellOfferOp.selling", canonicalAssetString(o.Selling)},
+			txRepLine{prefix + ".manageSellOfferOp.buying", canonicalAssetString(o.Buying)},
+			txRepLine{prefix + ".manageSellOfferOp.amount", o.Amount},
+			txRepLine{prefix + ".manageSellOfferOp.price", o.Price},
+			txRepLine{prefix + ".manageSellOfferOp.offerID", strconv.FormatInt(o.OfferID, 10)},
+		)
+	case *PathPaymentStrictSend:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "PATH_PAYMENT_STRICT_SEND"},
+			txRepLine{prefix + ".pathPaymentStrictSendOp.sendAsset", canonicalAssetString(o.SendAsset)},
+			txRepLine{prefix + ".pathPaymentStrictSendOp.sendAmount", o.SendAmount},
+			txRepLine{prefix + ".pathPaymentStrictSendOp.destination", o.Destination},
+			txRepLine{prefix + ".pathPaymentStrictSendOp.destAsset", canonicalAssetString(o.DestAsset)},
+			txRepLine{prefix + ".pathPaymentStrictSendOp.destMin", o.DestMin},
+		)
+	case *PathPaymentStrictReceive:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "PATH_PAYMENT_STRICT_RECEIVE"},
+			txRepLine{prefix + ".pathPaymentStrictReceiveOp.sendAsset", canonicalAssetString(o.SendAsset)},
+			txRepLine{prefix + ".pathPaymentStrictReceiveOp.sendMax", o.SendMax},
+			txRepLine{prefix + ".pathPaymentStrictReceiveOp.destination", o.Destination},
+			txRepLine{prefix + ".pathPaymentStrictReceiveOp.destAsset", canonicalAssetString(o.DestAsset)},
+			txRepLine{prefix + ".pathPaymentStrictReceiveOp.destAmount", o.DestAmount},
+		)
+	case *ChangeTrust:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "CHANGE_TRUST"},
+			txRepLine{prefix + ".changeTrustOp.line", canonicalAssetString(o.Line)},
+			txRepLine{prefix + ".changeTrustOp.limit", o.Limit},
+		)
+	case *AllowTrust:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "ALLOW_TRUST"},
+			txRepLine{prefix + ".allowTrustOp.trustor", o.Trustor},
+			txRepLine{prefix + ".allowTrustOp.assetCode", o.Type.GetCode()},
+			txRepLine{prefix + ".allowTrustOp.authorize", strconv.FormatBool(o.Authorize)},
+		)
+	case *SetOptions:
+		lines = append(lines, txRepLine{prefix + ".type", "SET_OPTIONS"})
+		if o.InflationDestination != nil {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.inflationDest", *o.InflationDestination})
+		}
+		if len(o.ClearFlags) > 0 {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.clearFlags", formatAccountFlags(o.ClearFlags)})
+		}
+		if len(o.SetFlags) > 0 {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.setFlags", formatAccountFlags(o.SetFlags)})
+		}
+		if o.MasterWeight != nil {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.masterWeight", strconv.FormatUint(uint64(*o.MasterWeight), 10)})
+		}
+		if o.LowThreshold != nil {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.lowThreshold", strconv.FormatUint(uint64(*o.LowThreshold), 10)})
+		}
+		if o.MediumThreshold != nil {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.medThreshold", strconv.FormatUint(uint64(*o.MediumThreshold), 10)})
+		}
+		if o.HighThreshold != nil {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.highThreshold", strconv.FormatUint(uint64(*o.HighThreshold), 10)})
+		}
+		if o.HomeDomain != nil {
+			lines = append(lines, txRepLine{prefix + ".setOptionsOp.homeDomain", *o.HomeDomain})
+		}
+		if o.Signer != nil {
+			lines = append(lines,
+				txRepLine{prefix + ".setOptionsOp.signer.key", o.Signer.Address},
+				txRepLine{prefix + ".setOptionsOp.signer.weight", strconv.FormatUint(uint64(o.Signer.Weight), 10)},
+			)
+		}
+	case *ManageData:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "MANAGE_DATA"},
+			txRepLine{prefix + ".manageDataOp.dataName", o.Name},
+			txRepLine{prefix + ".manageDataOp.dataValue", fmt.Sprintf("%x", o.Value)},
+		)
+	case *BumpSequence:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "BUMP_SEQUENCE"},
+			txRepLine{prefix + ".bumpSequenceOp.bumpTo", strconv.FormatInt(o.BumpTo, 10)},
+		)
+	case *CreateClaimableBalance:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "CREATE_CLAIMABLE_BALANCE"},
+			txRepLine{prefix + ".createClaimableBalanceOp.asset", canonicalAssetString(o.Asset)},
+			txRepLine{prefix + ".createClaimableBalanceOp.amount", o.Amount},
+		)
+		lines = append(lines, marshalClaimants(prefix+".createClaimableBalanceOp.claimants", o.Destinations)...)
+	case *ClaimClaimableBalance:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "CLAIM_CLAIMABLE_BALANCE"},
+			txRepLine{prefix + ".claimClaimableBalanceOp.balanceID", o.BalanceID},
+		)
+	case *BeginSponsoringFutureReserves:
+		lines = append(lines,
+			txRepLine{prefix + ".type", "BEGIN_SPONSORING_FUTURE_RESERVES"},
+			txRepLine{prefix + ".beginSponsoringFutureReservesOp.sponsoredID", o.SponsoredID},
+		)
+	case *EndSponsoringFutureReserves:
+		lines = append(lines, txRepLine{prefix + ".type", "END_SPONSORING_FUTURE_RESERVES"})
+	case *RevokeSponsorship:
+		revokeLines, err := marshalRevokeSponsorship(prefix, o)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, revokeLines...)
+	default:
+		return nil, errors.Errorf("unsupported operation type %T for txrep", op)
+	}
+
+	return lines, nil
+}
+
+// marshalRevokeSponsorship renders the revokeSponsorshipOp.* fields for the variant identified by
+// rs.Type.
+func marshalRevokeSponsorship(prefix string, rs *RevokeSponsorship) ([]txRepLine, error) {
+	lines := []txRepLine{{prefix + ".type", "REVOKE_SPONSORSHIP"}}
+
+	switch rs.Type {
+	case RevokeSponsorshipTypeAccount:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "ACCOUNT"},
+			txRepLine{prefix + ".revokeSponsorshipOp.account", *rs.Account},
+		)
+	case RevokeSponsorshipTypeTrustLine:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "TRUST_LINE"},
+			txRepLine{prefix + ".revokeSponsorshipOp.trustLine.account", rs.TrustLine.Account},
+			txRepLine{prefix + ".revokeSponsorshipOp.trustLine.asset", canonicalAssetString(rs.TrustLine.Asset)},
+		)
+	case RevokeSponsorshipTypeOffer:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "OFFER"},
+			txRepLine{prefix + ".revokeSponsorshipOp.offer.sellerID", rs.Offer.SellerID},
+			txRepLine{prefix + ".revokeSponsorshipOp.offer.offerID", strconv.FormatInt(rs.Offer.OfferID, 10)},
+		)
+	case RevokeSponsorshipTypeData:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "DATA"},
+			txRepLine{prefix + ".revokeSponsorshipOp.data.account", rs.Data.Account},
+			txRepLine{prefix + ".revokeSponsorshipOp.data.dataName", rs.Data.DataName},
+		)
+	case RevokeSponsorshipTypeClaimableBalance:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "CLAIMABLE_BALANCE"},
+			txRepLine{prefix + ".revokeSponsorshipOp.claimableBalanceID", *rs.ClaimableBalanceID},
+		)
+	case RevokeSponsorshipTypeLiquidityPool:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "LIQUIDITY_POOL"},
+			txRepLine{prefix + ".revokeSponsorshipOp.liquidityPoolID", *rs.LiquidityPoolID},
+		)
+	case RevokeSponsorshipTypeSigner:
+		lines = append(lines,
+			txRepLine{prefix + ".revokeSponsorshipOp.type", "SIGNER"},
+			txRepLine{prefix + ".revokeSponsorshipOp.signer.accountID", rs.Signer.AccountID},
+			txRepLine{prefix + ".revokeSponsorshipOp.signer.signerKey", rs.Signer.SignerKey},
+		)
+	default:
+		return nil, errors.Errorf("unsupported RevokeSponsorshipType %v for txrep", rs.Type)
+	}
+
+	return lines, nil
+}
+
+// marshalClaimants renders a list of Claimant as SEP-11 TxRep lines keyed under prefix, e.g.
+// prefix.len, prefix[0].type, prefix[0].v0.destination, prefix[0].v0.predicate.*.
+func marshalClaimants(prefix string, claimants []Claimant) []txRepLine {
+	lines := []txRepLine{{prefix + ".len", strconv.Itoa(len(claimants))}}
+	for i, claimant := range claimants {
+		claimantPrefix := fmt.Sprintf("%s[%d]", prefix, i)
+		lines = append(lines,
+			txRepLine{claimantPrefix + ".type", "CLAIMANT_TYPE_V0"},
+			txRepLine{claimantPrefix + ".v0.destination", claimant.Destination},
+		)
+		lines = append(lines, marshalClaimPredicate(claimantPrefix+".v0.predicate", claimant.Predicate)...)
+	}
+	return lines
+}
+
+// marshalClaimPredicate renders a ClaimPredicate as SEP-11 TxRep lines keyed under prefix,
+// recursing into And/Or/Not sub-predicates.
+func marshalClaimPredicate(prefix string, p ClaimPredicate) []txRepLine {
+	switch p.Type {
+	case ClaimPredicateUnconditionalType:
+		return []txRepLine{{prefix + ".type", "CLAIM_PREDICATE_UNCONDITIONAL"}}
+
+	case ClaimPredicateAndType:
+		lines := []txRepLine{{prefix + ".type", "CLAIM_PREDICATE_AND"}, {prefix + ".and.len", "2"}}
+		for i, sub := range p.AndPredicates {
+			lines = append(lines, marshalClaimPredicate(fmt.Sprintf("%s.and[%d]", prefix, i), sub)...)
+		}
+		return lines
+
+	case ClaimPredicateOrType:
+		lines := []txRepLine{{prefix + ".type", "CLAIM_PREDICATE_OR"}, {prefix + ".or.len", "2"}}
+		for i, sub := range p.OrPredicates {
+			lines = append(lines, marshalClaimPredicate(fmt.Sprintf("%s.or[%d]", prefix, i), sub)...)
+		}
+		return lines
+
+	case ClaimPredicateNotType:
+		lines := []txRepLine{{prefix + ".type", "CLAIM_PREDICATE_NOT"}}
+		if p.NotPredicate != nil {
+			lines = append(lines, marshalClaimPredicate(prefix+".not", *p.NotPredicate)...)
+		}
+		return lines
+
+	case ClaimPredicateBeforeAbsoluteTimeType:
+		return []txRepLine{
+			{prefix + ".type", "CLAIM_PREDICATE_BEFORE_ABSOLUTE_TIME"},
+			{prefix + ".absBefore", strconv.FormatInt(p.AbsBefore.Unix(), 10)},
+		}
+
+	case ClaimPredicateBeforeRelativeTimeType:
+		return []txRepLine{
+			{prefix + ".type", "CLAIM_PREDICATE_BEFORE_RELATIVE_TIME"},
+			{prefix + ".relBefore", strconv.FormatInt(int64(p.RelBefore/time.Second), 10)},
+		}
+
+	default:
+		return nil
+	}
+}
+
+// unmarshalOperation parses the tx.operations[i] block of a TxRep document back into an Operation.
+func unmarshalOperation(i int, fields map[string]string) (Operation, error) {
+	prefix := fmt.Sprintf("tx.operations[%d].body", i)
+	source := fields[fmt.Sprintf("tx.operations[%d].sourceAccount", i)]
+
+	switch fields[prefix+".type"] {
+	case "PAYMENT":
+		asset, err := ParseAssetString(fields[prefix+".paymentOp.asset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing payment asset")
+		}
+		return &Payment{
+			SourceAccount: source,
+			Destination:   fields[prefix+".paymentOp.destination"],
+			Amount:        fields[prefix+".paymentOp.amount"],
+			Asset:         asset,
+		}, nil
+
+	case "CREATE_ACCOUNT":
+		return &CreateAccount{
+			SourceAccount: source,
+			Destination:   fields[prefix+".createAccountOp.destination"],
+			Amount:        fields[prefix+".createAccountOp.startingBalance"],
+		}, nil
+
+	case "MANAGE_SELL_OFFER":
+		selling, err := ParseAssetString(fields[prefix+".manageSellOfferOp.selling"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing manageSellOfferOp.selling")
+		}
+		buying, err := ParseAssetString(fields[prefix+".manageSellOfferOp.buying"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing manageSellOfferOp.buying")
+		}
+		offerID, err := strconv.ParseInt(fields[prefix+".manageSellOfferOp.offerID"], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing manageSellOfferOp.offerID")
+		}
+		return &ManageSellOffer{
+			SourceAccount: source,
+			Selling:       selling,
+			Buying:        buying,
+			Amount:        fields[prefix+".manageSellOfferOp.amount"],
+			Price:         fields[prefix+".manageSellOfferOp.price"],
+			OfferID:       offerID,
+		}, nil
+
+	case "PATH_PAYMENT_STRICT_SEND":
+		sendAsset, err := ParseAssetString(fields[prefix+".pathPaymentStrictSendOp.sendAsset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing pathPaymentStrictSendOp.sendAsset")
+		}
+		destAsset, err := ParseAssetString(fields[prefix+".pathPaymentStrictSendOp.destAsset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing pathPaymentStrictSendOp.destAsset")
+		}
+		return &PathPaymentStrictSend{
+			SourceAccount: source,
+			SendAsset:     sendAsset,
+			SendAmount:    fields[prefix+".pathPaymentStrictSendOp.sendAmount"],
+			Destination:   fields[prefix+".pathPaymentStrictSendOp.destination"],
+			DestAsset:     destAsset,
+			DestMin:       fields[prefix+".pathPaymentStrictSendOp.destMin"],
+		}, nil
+
+	case "PATH_PAYMENT_STRICT_RECEIVE":
+		sendAsset, err := ParseAssetString(fields[prefix+".pathPaymentStrictReceiveOp.sendAsset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing pathPaymentStrictReceiveOp.sendAsset")
+		}
+		destAsset, err := ParseAssetString(fields[prefix+".pathPaymentStrictReceiveOp.destAsset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing pathPaymentStrictReceiveOp.destAsset")
+		}
+		return &PathPaymentStrictReceive{
+			SourceAccount: source,
+			SendAsset:     sendAsset,
+			SendMax:       fields[prefix+".pathPaymentStrictReceiveOp.sendMax"],
+			Destination:   fields[prefix+".pathPaymentStrictReceiveOp.destination"],
+			DestAsset:     destAsset,
+			DestAmount:    fields[prefix+".pathPaymentStrictReceiveOp.destAmount"],
+		}, nil
+
+	case "CHANGE_TRUST":
+		asset, err := ParseChangeTrustAssetString(fields[prefix+".changeTrustOp.line"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing change_trust asset")
+		}
+		return &ChangeTrust{
+			SourceAccount: source,
+			Line:          asset,
+			Limit:         fields[prefix+".changeTrustOp.limit"],
+		}, nil
+
+	case "ALLOW_TRUST":
+		authorize, err := strconv.ParseBool(fields[prefix+".allowTrustOp.authorize"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing allowTrustOp.authorize")
+		}
+		return &AllowTrust{
+			SourceAccount: source,
+			Trustor:       fields[prefix+".allowTrustOp.trustor"],
+			Type:          CreditAsset{Code: fields[prefix+".allowTrustOp.assetCode"]},
+			Authorize:     authorize,
+		}, nil
+
+	case "SET_OPTIONS":
+		so := &SetOptions{SourceAccount: source}
+		if v, ok := fields[prefix+".setOptionsOp.inflationDest"]; ok {
+			so.InflationDestination = &v
+		}
+		if v, ok := fields[prefix+".setOptionsOp.clearFlags"]; ok {
+			flags, err := parseAccountFlags(v)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.clearFlags")
+			}
+			so.ClearFlags = flags
+		}
+		if v, ok := fields[prefix+".setOptionsOp.setFlags"]; ok {
+			flags, err := parseAccountFlags(v)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.setFlags")
+			}
+			so.SetFlags = flags
+		}
+		if v, ok := fields[prefix+".setOptionsOp.masterWeight"]; ok {
+			weight, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.masterWeight")
+			}
+			w := uint32(weight)
+			so.MasterWeight = &w
+		}
+		if v, ok := fields[prefix+".setOptionsOp.lowThreshold"]; ok {
+			threshold, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.lowThreshold")
+			}
+			t := uint32(threshold)
+			so.LowThreshold = &t
+		}
+		if v, ok := fields[prefix+".setOptionsOp.medThreshold"]; ok {
+			threshold, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.medThreshold")
+			}
+			t := uint32(threshold)
+			so.MediumThreshold = &t
+		}
+		if v, ok := fields[prefix+".setOptionsOp.highThreshold"]; ok {
+			threshold, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.highThreshold")
+			}
+			t := uint32(threshold)
+			so.HighThreshold = &t
+		}
+		if v, ok := fields[prefix+".setOptionsOp.homeDomain"]; ok {
+			so.HomeDomain = &v
+		}
+		if key, ok := fields[prefix+".setOptionsOp.signer.key"]; ok {
+			weight, err := strconv.ParseUint(fields[prefix+".setOptionsOp.signer.weight"], 10, 32)
+			if err != nil {
+				return nil, errors.Wrap(err, "error parsing setOptionsOp.signer.weight")
+			}
+			so.Signer = &Signer{Address: key, Weight: uint32(weight)}
+		}
+		return so, nil
+
+	case "MANAGE_DATA":
+		value, err := hex.DecodeString(fields[prefix+".manageDataOp.dataValue"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing manageDataOp.dataValue")
+		}
+		return &ManageData{
+			SourceAccount: source,
+			Name:          fields[prefix+".manageDataOp.dataName"],
+			Value:         value,
+		}, nil
+
+	case "BUMP_SEQUENCE":
+		bumpTo, err := strconv.ParseInt(fields[prefix+".bumpSequenceOp.bumpTo"], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing bumpSequenceOp.bumpTo")
+		}
+		return &BumpSequence{SourceAccount: source, BumpTo: bumpTo}, nil
+
+	case "CREATE_CLAIMABLE_BALANCE":
+		asset, err := ParseAssetString(fields[prefix+".createClaimableBalanceOp.asset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing createClaimableBalanceOp.asset")
+		}
+		claimants, err := unmarshalClaimants(prefix+".createClaimableBalanceOp.claimants", fields)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing createClaimableBalanceOp.claimants")
+		}
+		return &CreateClaimableBalance{
+			SourceAccount: source,
+			Asset:         asset,
+			Amount:        fields[prefix+".createClaimableBalanceOp.amount"],
+			Destinations:  claimants,
+		}, nil
+
+	case "CLAIM_CLAIMABLE_BALANCE":
+		return &ClaimClaimableBalance{
+			SourceAccount: source,
+			BalanceID:     fields[prefix+".claimClaimableBalanceOp.balanceID"],
+		}, nil
+
+	case "BEGIN_SPONSORING_FUTURE_RESERVES":
+		return &BeginSponsoringFutureReserves{
+			SourceAccount: source,
+			SponsoredID:   fields[prefix+".beginSponsoringFutureReservesOp.sponsoredID"],
+		}, nil
+
+	case "END_SPONSORING_FUTURE_RESERVES":
+		return &EndSponsoringFutureReserves{SourceAccount: source}, nil
+
+	case "REVOKE_SPONSORSHIP":
+		return unmarshalRevokeSponsorship(prefix, source, fields)
+
+	default:
+		return nil, errors.Errorf("unsupported operation type %q for txrep", fields[prefix+".type"])
+	}
+}
+
+// unmarshalClaimants parses the prefix.len / prefix[i].* fields written by marshalClaimants back
+// into a list of Claimant.
+func unmarshalClaimants(prefix string, fields map[string]string) ([]Claimant, error) {
+	count, err := strconv.Atoi(fields[prefix+".len"])
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing "+prefix+".len")
+	}
+
+	claimants := make([]Claimant, count)
+	for i := 0; i < count; i++ {
+		claimantPrefix := fmt.Sprintf("%s[%d]", prefix, i)
+		predicate, err := unmarshalClaimPredicate(claimantPrefix+".v0.predicate", fields)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing claimant %d predicate", i)
+		}
+		claimants[i] = Claimant{
+			Destination: fields[claimantPrefix+".v0.destination"],
+			Predicate:   predicate,
+		}
+	}
+	return claimants, nil
+}
+
+// unmarshalClaimPredicate parses the prefix.* fields written by marshalClaimPredicate back into a
+// ClaimPredicate, recursing into And/Or/Not sub-predicates.
+func unmarshalClaimPredicate(prefix string, fields map[string]string) (ClaimPredicate, error) {
+	switch fields[prefix+".type"] {
+	case "CLAIM_PREDICATE_UNCONDITIONAL":
+		return ClaimPredicateUnconditional(), nil
+
+	case "CLAIM_PREDICATE_AND":
+		l, err := unmarshalClaimPredicate(prefix+".and[0]", fields)
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		r, err := unmarshalClaimPredicate(prefix+".and[1]", fields)
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		return ClaimPredicateAnd(l, r), nil
+
+	case "CLAIM_PREDICATE_OR":
+		l, err := unmarshalClaimPredicate(prefix+".or[0]", fields)
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		r, err := unmarshalClaimPredicate(prefix+".or[1]", fields)
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		return ClaimPredicateOr(l, r), nil
+
+	case "CLAIM_PREDICATE_NOT":
+		sub, err := unmarshalClaimPredicate(prefix+".not", fields)
+		if err != nil {
+			return ClaimPredicate{}, err
+		}
+		return ClaimPredicateNot(sub), nil
+
+	case "CLAIM_PREDICATE_BEFORE_ABSOLUTE_TIME":
+		unixSeconds, err := strconv.ParseInt(fields[prefix+".absBefore"], 10, 64)
+		if err != nil {
+			return ClaimPredicate{}, errors.Wrap(err, "error parsing "+prefix+".absBefore")
+		}
+		return ClaimPredicateBeforeAbsoluteTime(time.Unix(unixSeconds, 0).UTC()), nil
+
+	case "CLAIM_PREDICATE_BEFORE_RELATIVE_TIME":
+		seconds, err := strconv.ParseInt(fields[prefix+".relBefore"], 10, 64)
+		if err != nil {
+			return ClaimPredicate{}, errors.Wrap(err, "error parsing "+prefix+".relBefore")
+		}
+		return ClaimPredicateBeforeRelativeTime(time.Duration(seconds) * time.Second), nil
+
+	default:
+		return ClaimPredicate{}, errors.Errorf("unsupported ClaimPredicate type %q for txrep", fields[prefix+".type"])
+	}
+}
+
+// unmarshalRevokeSponsorship parses the revokeSponsorshipOp.* fields for the variant identified by
+// revokeSponsorshipOp.type back into a RevokeSponsorship.
+func unmarshalRevokeSponsorship(prefix, source string, fields map[string]string) (Operation, error) {
+	rs := &RevokeSponsorship{SourceAccount: source}
+
+	switch fields[prefix+".revokeSponsorshipOp.type"] {
+	case "ACCOUNT":
+		rs.Type = RevokeSponsorshipTypeAccount
+		account := fields[prefix+".revokeSponsorshipOp.account"]
+		rs.Account = &account
+
+	case "TRUST_LINE":
+		asset, err := ParseAssetString(fields[prefix+".revokeSponsorshipOp.trustLine.asset"])
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing revokeSponsorshipOp.trustLine.asset")
+		}
+		rs.Type = RevokeSponsorshipTypeTrustLine
+		rs.TrustLine = &TrustLineID{
+			Account: fields[prefix+".revokeSponsorshipOp.trustLine.account"],
+			Asset:   asset,
+		}
+
+	case "OFFER":
+		offerID, err := strconv.ParseInt(fields[prefix+".revokeSponsorshipOp.offer.offerID"], 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing revokeSponsorshipOp.offer.offerID")
+		}
+		rs.Type = RevokeSponsorshipTypeOffer
+		rs.Offer = &OfferID{
+			SellerID: fields[prefix+".revokeSponsorshipOp.offer.sellerID"],
+			OfferID:  offerID,
+		}
+
+	case "DATA":
+		rs.Type = RevokeSponsorshipTypeData
+		rs.Data = &DataID{
+			Account:  fields[prefix+".revokeSponsorshipOp.data.account"],
+			DataName: fields[prefix+".revokeSponsorshipOp.data.dataName"],
+		}
+
+	case "CLAIMABLE_BALANCE":
+		rs.Type = RevokeSponsorshipTypeClaimableBalance
+		id := fields[prefix+".revokeSponsorshipOp.claimableBalanceID"]
+		rs.ClaimableBalanceID = &id
+
+	case "LIQUIDITY_POOL":
+		rs.Type = RevokeSponsorshipTypeLiquidityPool
+		id := fields[prefix+".revokeSponsorshipOp.liquidityPoolID"]
+		rs.LiquidityPoolID = &id
+
+	case "SIGNER":
+		rs.Type = RevokeSponsorshipTypeSigner
+		rs.Signer = &SignerID{
+			AccountID: fields[prefix+".revokeSponsorshipOp.signer.accountID"],
+			SignerKey: fields[prefix+".revokeSponsorshipOp.signer.signerKey"],
+		}
+
+	default:
+		return nil, errors.Errorf("unsupported revokeSponsorshipOp.type %q for txrep", fields[prefix+".revokeSponsorshipOp.type"])
+	}
+
+	return rs, nil
+}
+
+// formatAccountFlags renders a list of AccountFlag values as a comma-separated string of their
+// integer values.
+func formatAccountFlags(flags []AccountFlag) string {
+	parts := make([]string, len(flags))
+	for i, f := range flags {
+		parts[i] = strconv.Itoa(int(f))
+	}
+	return strings.Join(parts, ",")
+}
+
+// parseAccountFlags parses a comma-separated string of integer AccountFlag values, as rendered by
+// formatAccountFlags.
+func parseAccountFlags(v string) ([]AccountFlag, error) {
+	if v == "" {
+		return nil, nil
+	}
+	parts := strings.Split(v, ",")
+	flags := make([]AccountFlag, len(parts))
+	for i, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing account flag %q", p)
+		}
+		flags[i] = AccountFlag(n)
+	}
+	return flags, nil
+}
+
+// ParseChangeTrustAssetString parses a SEP-11 canonical asset string into a ChangeTrustAsset. Pool
+// share references, rendered by LiquidityPoolAsset.CanonicalString as
+// "liquidity_pool:<assetA>:<assetB>:<fee>", are recognized in addition to the plain native/credit
+// forms.
+func ParseChangeTrustAssetString(canonical string) (ChangeTrustAsset, error) {
+	if strings.HasPrefix(canonical, "liquidity_pool:") {
+		return parseLiquidityPoolAssetString(canonical)
+	}
+
+	asset, err := ParseAssetString(canonical)
+	if err != nil {
+		return nil, err
+	}
+	return asset.ToChangeTrustAsset()
+}
+
+// parseLiquidityPoolAssetString parses the "liquidity_pool:<assetA>:<assetB>:<fee>" form produced
+// by LiquidityPoolAsset.CanonicalString back into a LiquidityPoolAsset.
+func parseLiquidityPoolAssetString(canonical string) (ChangeTrustAsset, error) {
+	// Each asset is either "native" or "CODE:ISSUER", so AssetA spans one or two parts, AssetB the
+	// parts up to the last one, and the fee is the last part.
+	parts := strings.Split(strings.TrimPrefix(canonical, "liquidity_pool:"), ":")
+	lenA := 2
+	if parts[0] == "native" {
+		lenA = 1
+	}
+	if len(parts) < lenA+2 {
+		return nil, errors.Errorf("malformed liquidity pool asset string %q", canonical)
+	}
+
+	assetA, err := ParseAssetString(strings.Join(parts[:lenA], ":"))
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing liquidity pool AssetA")
+	}
+	assetB, err := ParseAssetString(strings.Join(parts[lenA:len(parts)-1], ":"))
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing liquidity pool AssetB")
+	}
+	fee, err := strconv.ParseInt(parts[len(parts)-1], 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing liquidity pool fee")
+	}
+
+	return NewLiquidityPoolShareChangeTrustAsset(assetA, assetB, int32(fee))
+}
+
+// canonicalAssetString renders the SEP-11 canonical string for an asset, falling back to "native"
+// when the asset itself is nil (as may happen for not-yet-populated operations).
+func canonicalAssetString(asset BasicAsset) string {
+	if asset == nil {
+		return "native"
+	}
+	return asset.CanonicalString()
+}
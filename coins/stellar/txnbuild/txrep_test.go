@@ -0,0 +1,140 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalTxRepPayment(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), 9605939170639897)
+
+	payment := Payment{
+		Destination: newKeypair1().Address(),
+		Amount:      "10",
+		Asset:       NativeAsset{},
+	}
+
+	tx, err := NewTransaction(TransactionParams{
+		SourceAccount:        &sourceAccount,
+		IncrementSequenceNum: true,
+		Operations:           []Operation{&payment},
+		BaseFee:              MinBaseFee,
+		Timebounds:           NewInfiniteTimeout(),
+	})
+	assert.NoError(t, err)
+
+	rep, err := MarshalTxRep(tx)
+	assert.NoError(t, err)
+	assert.Contains(t, rep, "tx.sourceAccount: "+kp0.Address())
+	assert.Contains(t, rep, "tx.operations[0].body.type: PAYMENT")
+	assert.Contains(t, rep, "tx.operations[0].body.paymentOp.asset: native")
+}
+
+// fieldsFromLines flattens marshalOperation's output into the map shape unmarshalOperation
+// expects, mirroring how UnmarshalTxRep assembles fields from a parsed document.
+func fieldsFromLines(lines []txRepLine) map[string]string {
+	fields := make(map[string]string, len(lines))
+	for _, l := range lines {
+		fields[l.key] = l.value
+	}
+	return fields
+}
+
+func TestTxRepOperationRoundTrip(t *testing.T) {
+	issuer := newKeypair1().Address()
+	credit := CreditAsset{Code: "ABCD", Issuer: issuer}
+
+	cases := []struct {
+		name string
+		op   Operation
+	}{
+		{"Payment", &Payment{Destination: newKeypair1().Address(), Amount: "10", Asset: NativeAsset{}}},
+		{"CreateAccount", &CreateAccount{Destination: newKeypair1().Address(), Amount: "50"}},
+		{"ManageSellOffer", &ManageSellOffer{Selling: NativeAsset{}, Buying: credit, Amount: "100", Price: "1.5", OfferID: 7}},
+		{"PathPaymentStrictSend", &PathPaymentStrictSend{SendAsset: NativeAsset{}, SendAmount: "10", Destination: newKeypair1().Address(), DestAsset: credit, DestMin: "9"}},
+		{"PathPaymentStrictReceive", &PathPaymentStrictReceive{SendAsset: credit, SendMax: "10", Destination: newKeypair1().Address(), DestAsset: NativeAsset{}, DestAmount: "9"}},
+		{"ChangeTrust", &ChangeTrust{Line: credit, Limit: "1000"}},
+		{"AllowTrust", &AllowTrust{Trustor: newKeypair1().Address(), Type: credit, Authorize: true}},
+		{"SetOptions", &SetOptions{HomeDomain: stringPtr("example.com"), Signer: &Signer{Address: newKeypair1().Address(), Weight: 1}}},
+		{"ManageData", &ManageData{Name: "key", Value: []byte("value")}},
+		{"BumpSequence", &BumpSequence{BumpTo: 100}},
+		{"CreateClaimableBalance", &CreateClaimableBalance{
+			Asset:  NativeAsset{},
+			Amount: "10",
+			Destinations: []Claimant{
+				NewClaimant(newKeypair1().Address(), nil),
+				NewClaimant(newKeypair2().Address(), predicatePtr(ClaimPredicateNot(ClaimPredicateUnconditional()))),
+			},
+		}},
+		{"ClaimClaimableBalance", &ClaimClaimableBalance{BalanceID: "00000000aabbccdd"}},
+		{"BeginSponsoringFutureReserves", &BeginSponsoringFutureReserves{SponsoredID: newKeypair1().Address()}},
+		{"EndSponsoringFutureReserves", &EndSponsoringFutureReserves{}},
+		{"RevokeSponsorshipAccount", &RevokeSponsorship{Type: RevokeSponsorshipTypeAccount, Account: stringPtr(newKeypair1().Address())}},
+		{"RevokeSponsorshipSigner", &RevokeSponsorship{Type: RevokeSponsorshipTypeSigner, Signer: &SignerID{AccountID: newKeypair1().Address(), SignerKey: newKeypair2().Address()}}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			lines, err := marshalOperation(0, c.op)
+			assert.NoError(t, err)
+
+			parsed, err := unmarshalOperation(0, fieldsFromLines(lines))
+			assert.NoError(t, err)
+
+			roundTripLines, err := marshalOperation(0, parsed)
+			assert.NoError(t, err)
+			assert.Equal(t, lines, roundTripLines)
+		})
+	}
+}
+
+func TestMemoTextRoundTrip(t *testing.T) {
+	cases := []string{
+		`hello "world"`,
+		`back\slash`,
+		"plain",
+	}
+
+	for _, text := range cases {
+		t.Run(text, func(t *testing.T) {
+			lines := marshalMemo(MemoText(text))
+			parsed, err := unmarshalMemo(fieldsFromLines(lines))
+			assert.NoError(t, err)
+			assert.Equal(t, MemoText(text), parsed)
+		})
+	}
+}
+
+func stringPtr(s string) *string { return &s }
+
+func predicatePtr(p ClaimPredicate) *ClaimPredicate { return &p }
+
+func TestUnmarshalTxRepRoundTrip(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := NewSimpleAccount(kp0.Address(), 9605939170639897)
+
+	payment := Payment{
+		Destination: newKeypair1().Address(),
+		Amount:      "10",
+		Asset:       NativeAsset{},
+	}
+
+	tx, err := NewTransaction(TransactionParams{
+		SourceAccount:        &sourceAccount,
+		IncrementSequenceNum: true,
+		Operations:           []Operation{&payment},
+		BaseFee:              MinBaseFee,
+		Timebounds:           NewInfiniteTimeout(),
+	})
+	assert.NoError(t, err)
+
+	rep, err := MarshalTxRep(tx)
+	assert.NoError(t, err)
+
+	parsed, err := UnmarshalTxRep(rep)
+	assert.NoError(t, err)
+	assert.Equal(t, tx.SourceAccount().AccountID, parsed.SourceAccount().AccountID)
+	assert.Len(t, parsed.Operations(), 1)
+}